@@ -1,3 +1,3 @@
 module github.com/aclements/go-moremath
 
-go 1.12
+go 1.18
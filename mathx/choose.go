@@ -4,7 +4,10 @@
 
 package mathx
 
-import "math"
+import (
+	"math"
+	"math/big"
+)
 
 const smallFactLimit = 20 // 20! => 62 bits
 var smallFact [smallFactLimit + 1]int64
@@ -19,6 +22,13 @@ func init() {
 }
 
 // Choose returns the binomial coefficient of n and k.
+//
+// The result is exact, up to rounding to float64, if the binomial
+// coefficient is small enough to compute in 64-bit integer
+// arithmetic. Otherwise, Choose computes it from the log-gamma
+// function, which has a relative error of up to about 1e-13, and
+// returns +Inf if it exceeds the range of float64. Use ChooseBig
+// for exact results.
 func Choose(n, k int) float64 {
 	if k == 0 || k == n {
 		return 1
@@ -40,7 +50,38 @@ func Choose(n, k int) float64 {
 		return float64(numer / denom)
 	}
 
-	return math.Exp(lchoose(n, k))
+	// Compute C(n-k+i, i) = C(n-k+i-1, i-1) * (n-k+i) / i for
+	// i = 1, ..., k. Dividing out the common factors first keeps
+	// every step exact as long as the result fits in a uint64.
+	if k > n-k {
+		k = n - k
+	}
+	c := uint64(1)
+	for i := 1; i <= k; i++ {
+		g := gcd(c, uint64(i))
+		m := uint64(n-k+i) / (uint64(i) / g)
+		c /= g
+		if c > math.MaxUint64/m {
+			return math.Exp(lchoose(n, k))
+		}
+		c *= m
+	}
+	return float64(c)
+}
+
+func gcd(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// ChooseBig returns the exact binomial coefficient of n and k.
+func ChooseBig(n, k int) *big.Int {
+	if k < 0 || n < k {
+		return new(big.Int)
+	}
+	return new(big.Int).Binomial(int64(n), int64(k))
 }
 
 // Lchoose returns math.Log(Choose(n, k)).
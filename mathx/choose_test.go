@@ -0,0 +1,53 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mathx
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestChooseBig(t *testing.T) {
+	want, _ := new(big.Int).SetString("100891344545564193334812497256", 10)
+	if got := ChooseBig(100, 50); got.Cmp(want) != 0 {
+		t.Errorf("ChooseBig(100, 50) = %v, want %v", got, want)
+	}
+	for _, nk := range [][2]int{{5, -1}, {5, 6}} {
+		if got := ChooseBig(nk[0], nk[1]); got.Sign() != 0 {
+			t.Errorf("ChooseBig(%d, %d) = %v, want 0", nk[0], nk[1], got)
+		}
+	}
+}
+
+func TestChoose(t *testing.T) {
+	// Choose is correctly rounded wherever it can be computed
+	// exactly in 64 bits. C(66, 33) is the largest central
+	// binomial coefficient that fits in a uint64.
+	for n := 0; n <= 66; n++ {
+		for k := 0; k <= n; k++ {
+			want, _ := new(big.Float).SetInt(ChooseBig(n, k)).Float64()
+			if got := Choose(n, k); got != want {
+				t.Errorf("Choose(%d, %d) = %v, want %v", n, k, got, want)
+			}
+		}
+	}
+	if got, want := Choose(1000000, 3), 166666166667000000.0; got != want {
+		t.Errorf("Choose(1000000, 3) = %v, want %v", got, want)
+	}
+
+	// Larger results are approximate.
+	want, _ := new(big.Float).SetInt(ChooseBig(100, 50)).Float64()
+	if got := Choose(100, 50); math.Abs(got-want) > 1e-12*want {
+		t.Errorf("Choose(100, 50) = %v, want %v", got, want)
+	}
+	if got := Choose(2000, 1000); !math.IsInf(got, 1) {
+		t.Errorf("Choose(2000, 1000) = %v, want +Inf", got)
+	}
+
+	if got := Choose(5, 6); got != 0 {
+		t.Errorf("Choose(5, 6) = %v, want 0", got)
+	}
+}
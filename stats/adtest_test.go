@@ -0,0 +1,45 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestAndersonDarlingTest(t *testing.T) {
+	xs := []float64{2.1, 3.4, 1.9, 5.6, 4.4, 3.3, 2.8, 4.0, 3.7, 2.5}
+	res, err := AndersonDarlingTest(xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.N != 10 || !aeq(res.A2, 0.17298908817172176) ||
+		!aeq(res.A2Star, 0.1898555242684646) || !aeq(res.P, 0.8995793077044423) {
+		t.Errorf("AndersonDarlingTest(%v) = %+v", xs, res)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	res, err = AndersonDarlingTest(SampleN(NormalDist{10, 3}, 200, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("normal sample: P = %v, want large", res.P)
+	}
+	res, err = AndersonDarlingTest(SampleN(ExponentialDist{1}, 200, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P > 1e-4 {
+		t.Errorf("exponential sample: P = %v, want small", res.P)
+	}
+
+	if _, err := AndersonDarlingTest(xs[:7]); err != ErrSampleSize {
+		t.Errorf("AndersonDarlingTest with 7 values returned error %v, want ErrSampleSize", err)
+	}
+	if _, err := AndersonDarlingTest([]float64{1, 1, 1, 1, 1, 1, 1, 1}); err != ErrZeroVariance {
+		t.Errorf("AndersonDarlingTest of constant sample returned error %v, want ErrZeroVariance", err)
+	}
+}
@@ -8,6 +8,9 @@ package stats
 
 import (
 	"fmt"
+	"math"
+	"runtime"
+	"sync"
 
 	"github.com/jgbaldwinbrown/go-moremath/mathx"
 )
@@ -34,6 +37,49 @@ func sumint(xs []int) int {
 	return sum
 }
 
+// atEach returns f(x) for each x in xs.
+func atEach(f func(float64) float64, xs []float64) []float64 {
+	out := make([]float64, len(xs))
+	for i, x := range xs {
+		out[i] = f(x)
+	}
+	return out
+}
+
+// atEachParallel is like atEach, but evaluates f concurrently using
+// up to workers goroutines. f must be safe to call concurrently. If
+// workers <= 0, it uses runtime.GOMAXPROCS(0) workers.
+//
+// This is only worthwhile if f is expensive; otherwise the cost of
+// the goroutines dominates.
+func atEachParallel(f func(float64) float64, xs []float64, workers int) []float64 {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > len(xs) {
+		workers = len(xs)
+	}
+	if workers <= 1 {
+		return atEach(f, xs)
+	}
+
+	// Split xs into contiguous chunks, one per worker.
+	out := make([]float64, len(xs))
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		lo, hi := w*len(xs)/workers, (w+1)*len(xs)/workers
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := lo; i < hi; i++ {
+				out[i] = f(xs[i])
+			}
+		}()
+	}
+	wg.Wait()
+	return out
+}
+
 // bisect returns an x in [low, high] such that |f(x)| <= tolerance
 // using the bisection method.
 //
@@ -72,6 +118,139 @@ func bisect(f func(float64) float64, low, high, tolerance float64) (float64, boo
 	}
 }
 
+// brent returns an x in [low, high] such that |f(x)| <= tolerance
+// using Brent's method, which combines inverse quadratic
+// interpolation and the secant method with a bisection fallback. It
+// keeps the root bracketed at every step, so it is as robust as
+// bisect, but typically converges superlinearly.
+//
+// f(low) and f(high) must have opposite signs.
+//
+// If f does not have a root in this interval (e.g., it is
+// discontiguous), this returns the X of the apparent discontinuity
+// and false.
+func brent(f func(float64) float64, low, high, tolerance float64) (float64, bool) {
+	a, b := low, high
+	fa, fb := f(a), f(b)
+	if -tolerance <= fa && fa <= tolerance {
+		return a, true
+	}
+	if -tolerance <= fb && fb <= tolerance {
+		return b, true
+	}
+	if mathx.Sign(fa) == mathx.Sign(fb) {
+		panic(fmt.Sprintf("root of f is not bracketed by [low, high]; f(%g)=%g f(%g)=%g", low, fa, high, fb))
+	}
+
+	// This follows Brent's ZEROIN. b is the current best estimate,
+	// a is the previous estimate, and the root is always bracketed
+	// by [b, c].
+	const eps = 2.220446049250313e-16 // 2^-52
+	c, fc := a, fa
+	d := b - a
+	e := d
+	for {
+		if mathx.Sign(fb) == mathx.Sign(fc) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		if -tolerance <= fb && fb <= tolerance {
+			return b, true
+		}
+		xtol := 2*eps*math.Abs(b) + math.SmallestNonzeroFloat64
+		m := (c - b) / 2
+		if math.Abs(m) <= xtol {
+			// The bracket has collapsed without f
+			// reaching zero.
+			return b, false
+		}
+
+		if math.Abs(e) >= xtol && math.Abs(fa) > math.Abs(fb) {
+			// Try interpolation.
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// Secant method.
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// Inverse quadratic interpolation.
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(xtol*q), math.Abs(e*q)) {
+				// Accept the interpolation.
+				e = d
+				d = p / q
+			} else {
+				// Interpolation failed; bisect.
+				d = m
+				e = d
+			}
+		} else {
+			// Convergence is too slow; bisect.
+			d = m
+			e = d
+		}
+
+		a, fa = b, fb
+		if math.Abs(d) > xtol {
+			b += d
+		} else if m > 0 {
+			b += xtol
+		} else {
+			b -= xtol
+		}
+		fb = f(b)
+	}
+}
+
+// newton returns an x such that |f(x)| <= tolerance using the
+// Newton-Raphson method starting from x0, where fprime is the
+// derivative of f.
+//
+// Unlike bisect and brent, newton does not require a bracket and
+// may not converge. If it does not converge within maxIter steps, or
+// it reaches a point where fprime is zero or the Newton step is not
+// finite, it returns the last x and false, so the caller can fall
+// back to a bracketing method.
+func newton(f, fprime func(float64) float64, x0, tolerance float64, maxIter int) (float64, bool) {
+	x := x0
+	for i := 0; i < maxIter; i++ {
+		fx := f(x)
+		if -tolerance <= fx && fx <= tolerance {
+			return x, true
+		}
+		d := fprime(x)
+		if d == 0 {
+			return x, false
+		}
+		step := fx / d
+		if math.IsNaN(step) || math.IsInf(step, 0) {
+			return x, false
+		}
+		next := x - step
+		if next == x {
+			// The step is below the resolution of x.
+			return x, false
+		}
+		x = next
+	}
+	return x, false
+}
+
 // bisectBool implements the bisection method on a boolean function.
 // It returns x1, x2 ∈ [low, high], x1 < x2 such that f(x1) != f(x2)
 // and x2 - x1 <= xtol.
@@ -101,14 +280,320 @@ func bisectBool(f func(float64) bool, low, high, xtol float64) (x1, x2 float64)
 	}
 }
 
-// series returns the sum of the series f(0), f(1), ...
+// maxSeriesTerms is the maximum number of terms series will sum.
+const maxSeriesTerms = 1 << 20
+
+// series returns the sum of the series f(0), f(1), ... It stops when
+// a term is too small to change the sum, or after maxSeriesTerms
+// terms, in which case it returns the partial sum.
 //
-// This implementation is fast, but subject to round-off error.
+// The sum is computed using Kahan summation to limit round-off
+// error.
 func series(f func(float64) float64) float64 {
-	y, yp := 0.0, 1.0
-	for n := 0.0; y != yp; n++ {
-		yp = y
-		y += f(n)
+	sum, c := 0.0, 0.0
+	for n := 0; n < maxSeriesTerms; n++ {
+		term := f(float64(n))
+		if sum+term == sum {
+			break
+		}
+		y := term - c
+		t := sum + y
+		c = (t - sum) - y
+		sum = t
+	}
+	return sum
+}
+
+// aitken returns a fixed point x = f(x) by starting from x0 and
+// iterating f, using Aitken's δ² process to accelerate convergence
+// (also known as Steffensen's method). Each step evaluates f twice.
+// For iterations that converge linearly, this typically converges
+// quadratically.
+//
+// aitken stops when successive estimates differ by at most
+// tolerance. If it does not converge within maxIter steps, or the
+// iteration produces a non-finite value, it returns the last
+// estimate and false.
+func aitken(f func(float64) float64, x0, tolerance float64, maxIter int) (float64, bool) {
+	x := x0
+	for i := 0; i < maxIter; i++ {
+		x1 := f(x)
+		x2 := f(x1)
+		denom := x2 - 2*x1 + x
+		var next float64
+		if denom == 0 {
+			// The iterates are (numerically) on a line,
+			// so acceleration can't help.
+			next = x2
+		} else {
+			next = x - (x1-x)*(x1-x)/denom
+		}
+		if math.IsNaN(next) || math.IsInf(next, 0) {
+			return x, false
+		}
+		if math.Abs(next-x) <= tolerance {
+			return next, true
+		}
+		x = next
+	}
+	return x, false
+}
+
+// minimize returns the x in [a, b] that minimizes f, and f(x), using
+// Brent's method, which combines golden-section search with
+// successive parabolic interpolation. f should be unimodal on
+// [a, b]; otherwise, this finds a local minimum. The result is
+// accurate to within about tolerance, or to the square root of
+// machine precision relative to x, whichever is larger.
+func minimize(f func(float64) float64, a, b, tolerance float64) (xmin, fmin float64) {
+	// This follows Brent's FMIN. See Brent, R. P. (1973).
+	// Algorithms for Minimization without Derivatives, chapter 5.
+	const (
+		golden  = 0.3819660112501051     // (3 - √5) / 2
+		sqrtEps = 1.4901161193847656e-08 // 2^-26
+	)
+	if a > b {
+		a, b = b, a
+	}
+	// x is the best point so far, w the second best, and v the
+	// previous value of w.
+	x := a + golden*(b-a)
+	w, v := x, x
+	fx := f(x)
+	fw, fv := fx, fx
+	var d, e float64
+	for {
+		m := (a + b) / 2
+		tol := sqrtEps*math.Abs(x) + tolerance/3
+		tol2 := 2 * tol
+		if math.Abs(x-m) <= tol2-(b-a)/2 {
+			return x, fx
+		}
+
+		useGolden := true
+		if math.Abs(e) > tol {
+			// Try a parabola through x, w, and v.
+			r := (x - w) * (fx - fv)
+			q := (x - v) * (fx - fw)
+			p := (x-v)*q - (x-w)*r
+			q = 2 * (q - r)
+			if q > 0 {
+				p = -p
+			} else {
+				q = -q
+			}
+			if math.Abs(p) < math.Abs(q*e/2) && p > q*(a-x) && p < q*(b-x) {
+				// The parabolic step is within the bracket
+				// and shrinking.
+				e = d
+				d = p / q
+				u := x + d
+				// f must not be evaluated too close to a or b.
+				if u-a < tol2 || b-u < tol2 {
+					if x < m {
+						d = tol
+					} else {
+						d = -tol
+					}
+				}
+				useGolden = false
+			}
+		}
+		if useGolden {
+			if x < m {
+				e = b - x
+			} else {
+				e = a - x
+			}
+			d = golden * e
+		}
+
+		// f must not be evaluated too close to x.
+		u := x + d
+		if math.Abs(d) < tol {
+			if d > 0 {
+				u = x + tol
+			} else {
+				u = x - tol
+			}
+		}
+		fu := f(u)
+
+		if fu <= fx {
+			if u < x {
+				b = x
+			} else {
+				a = x
+			}
+			v, fv = w, fw
+			w, fw = x, fx
+			x, fx = u, fu
+		} else {
+			if u < x {
+				a = u
+			} else {
+				b = u
+			}
+			if fu <= fw || w == x {
+				v, fv = w, fw
+				w, fw = u, fu
+			} else if fu <= fv || v == x || v == w {
+				v, fv = u, fu
+			}
+		}
+	}
+}
+
+// derivative returns an estimate of f'(x) using the central
+// difference (f(x+h) - f(x-h)) / 2h. The error is O(h²), but h that
+// is too small loses precision to round-off.
+func derivative(f func(float64) float64, x, h float64) float64 {
+	// Make sure x+h and x-h are exactly representable distances
+	// from x.
+	xh := x + h
+	h = xh - x
+	return (f(x+h) - f(x-h)) / (2 * h)
+}
+
+// derivativeRichardson returns an estimate of f'(x) using Ridders'
+// method, which applies Richardson extrapolation to a sequence of
+// central differences with shrinking step sizes. It is typically
+// accurate to near machine precision for smooth f.
+//
+// The initial step is 0.1 max(1, |x|) and shrinks geometrically,
+// so f should be smooth on roughly that scale around x.
+func derivativeRichardson(f func(float64) float64, x float64) float64 {
+	// See Ridders, C. J. F. (1982). "Accurate computation of F'(x)
+	// and F'(x)F''(x)". Advances in Engineering Software 4 (2):
+	// 75–76.
+	const (
+		levels = 20
+		con    = 1.4
+		con2   = con * con
+		safe   = 2
+	)
+	h := 0.1 * math.Max(1, math.Abs(x))
+	var a [levels][levels]float64
+	a[0][0] = derivative(f, x, h)
+	best, err := a[0][0], inf
+	for i := 1; i < levels; i++ {
+		h /= con
+		a[0][i] = derivative(f, x, h)
+		fac := con2
+		for j := 1; j <= i; j++ {
+			// Extrapolate to higher orders.
+			a[j][i] = (a[j-1][i]*fac - a[j-1][i-1]) / (fac - 1)
+			fac *= con2
+			e := math.Max(math.Abs(a[j][i]-a[j-1][i]), math.Abs(a[j][i]-a[j-1][i-1]))
+			if e <= err {
+				best, err = a[j][i], e
+			}
+		}
+		if math.Abs(a[i][i]-a[i-1][i-1]) >= safe*err {
+			// Higher order is getting worse.
+			break
+		}
+	}
+	return best
+}
+
+// integrate returns the integral of f over [low, high] using the
+// composite Simpson's rule with n subintervals. If n is odd, it is
+// rounded up to the next even number. If low > high, the result is
+// the negated integral over [high, low].
+func integrate(f func(float64) float64, low, high float64, n int) float64 {
+	if low > high {
+		return -integrate(f, high, low, n)
+	}
+	if n < 2 {
+		n = 2
+	}
+	if n%2 == 1 {
+		n++
+	}
+	h := (high - low) / float64(n)
+	sum := f(low) + f(high)
+	for i := 1; i < n; i++ {
+		x := low + float64(i)*h
+		if i%2 == 1 {
+			sum += 4 * f(x)
+		} else {
+			sum += 2 * f(x)
+		}
+	}
+	return sum * h / 3
+}
+
+// integrateAdaptive returns the integral of f over [low, high] using
+// adaptive Simpson's rule. It recursively subdivides intervals until
+// the estimated error on each is at most tolerance, scaled by the
+// fraction of the full interval it covers.
+//
+// If f is not finite anywhere it is evaluated, integrateAdaptive
+// returns that non-finite value. Subdivision is limited to a fixed
+// depth and a fixed number of evaluations of f, so
+// integrateAdaptive always terminates, though the result may not
+// meet tolerance if f is badly behaved.
+//
+// If low > high, the result is the negated integral over [high, low].
+func integrateAdaptive(f func(float64) float64, low, high, tolerance float64) float64 {
+	if low > high {
+		return -integrateAdaptive(f, high, low, tolerance)
+	}
+	const maxDepth = 40
+	const maxEvals = 1 << 20
+	evals := 0
+	finite := func(x float64) bool {
+		return !math.IsNaN(x) && !math.IsInf(x, 0)
+	}
+	var rec func(a, b, fa, fm, fb, whole, tol float64, depth int) float64
+	rec = func(a, b, fa, fm, fb, whole, tol float64, depth int) float64 {
+		m := (a + b) / 2
+		lm, rm := (a+m)/2, (m+b)/2
+		flm, frm := f(lm), f(rm)
+		evals += 2
+		left := (m - a) / 6 * (fa + 4*flm + fm)
+		right := (b - m) / 6 * (fm + 4*frm + fb)
+		if !finite(left) || !finite(right) {
+			return left + right
+		}
+		delta := left + right - whole
+		if depth <= 0 || evals >= maxEvals || math.Abs(delta) <= 15*tol || m == a || m == b {
+			// Richardson extrapolation.
+			return left + right + delta/15
+		}
+		return rec(a, m, fa, flm, fm, left, tol/2, depth-1) +
+			rec(m, b, fm, frm, fb, right, tol/2, depth-1)
+	}
+	fa, fb, fm := f(low), f(high), f((low+high)/2)
+	whole := (high - low) / 6 * (fa + 4*fm + fb)
+	if !finite(whole) {
+		return whole
+	}
+	return rec(low, high, fa, fm, fb, whole, tolerance, maxDepth)
+}
+
+// invDiscreteCDF returns the smallest integer k >= lo such that
+// cdf(k) >= p, where cdf is the CDF of an integer-valued
+// distribution. If hi >= lo, the result is limited to [lo, hi].
+// Otherwise, the search is unbounded above.
+func invDiscreteCDF(cdf func(float64) float64, p float64, lo, hi int) int {
+	if hi < lo {
+		// Find an upper bound by doubling.
+		hi = maxint(lo, 1)
+		for cdf(float64(hi)) < p && hi < math.MaxInt32/2 {
+			hi *= 2
+		}
+	}
+	// Invariant: cdf(lo-1) < p <= cdf(hi), or hi is the upper
+	// limit.
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if cdf(float64(mid)) < p {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
 	}
-	return y
+	return lo
 }
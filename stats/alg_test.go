@@ -0,0 +1,341 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestAtEachParallel(t *testing.T) {
+	d := GammaDist{Shape: 2.5, Scale: 1.5}
+	for _, n := range []int{0, 1, 7, 1000} {
+		xs := vec.Linspace(0, 1, n)
+		want := atEach(d.InvCDF, xs)
+		for _, workers := range []int{-1, 0, 1, 2, 3, 16, 2000} {
+			got := atEachParallel(d.InvCDF, xs, workers)
+			if len(got) != len(want) {
+				t.Errorf("len(atEachParallel(n=%d, workers=%d)) = %d, want %d", n, workers, len(got), len(want))
+				continue
+			}
+			for i := range want {
+				if got[i] != want[i] && !(math.IsNaN(got[i]) && math.IsNaN(want[i])) {
+					t.Errorf("atEachParallel(n=%d, workers=%d)[%d] = %v, want %v", n, workers, i, got[i], want[i])
+					break
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkAtEach(b *testing.B) {
+	d := GammaDist{Shape: 2.5, Scale: 1.5}
+	xs := vec.Linspace(0.001, 0.999, 256)
+	for i := 0; i < b.N; i++ {
+		atEach(d.InvCDF, xs)
+	}
+}
+
+func BenchmarkAtEachParallel(b *testing.B) {
+	d := GammaDist{Shape: 2.5, Scale: 1.5}
+	xs := vec.Linspace(0.001, 0.999, 256)
+	for i := 0; i < b.N; i++ {
+		atEachParallel(d.InvCDF, xs, 0)
+	}
+}
+
+func TestBrent(t *testing.T) {
+	// x³ - 2x - 5 has a single real root.
+	const root = 2.0945514815423265
+	cubic := func(x float64) float64 { return x*x*x - 2*x - 5 }
+	if x, ok := brent(cubic, 2, 3, 1e-14); !ok || math.Abs(x-root) > 1e-14 {
+		t.Errorf("brent(x³-2x-5, 2, 3) = %v, %v, want %v, true", x, ok, root)
+	}
+	// The bracket can be given in either order.
+	if x, ok := brent(cubic, 3, 2, 1e-14); !ok || math.Abs(x-root) > 1e-14 {
+		t.Errorf("brent(x³-2x-5, 3, 2) = %v, %v, want %v, true", x, ok, root)
+	}
+	// A root at an endpoint.
+	if x, ok := brent(math.Sin, 0, 1, 1e-14); !ok || x != 0 {
+		t.Errorf("brent(sin, 0, 1) = %v, %v, want 0, true", x, ok)
+	}
+	// A root at zero in the interior.
+	if x, ok := brent(math.Sin, -1, 2, 0); !ok || x != 0 {
+		t.Errorf("brent(sin, -1, 2, 0) = %v, %v, want 0, true", x, ok)
+	}
+
+	// A discontinuity is reported as no root.
+	step := func(x float64) float64 {
+		if x < 1 {
+			return -1
+		}
+		return 1
+	}
+	if x, ok := brent(step, 0, 3, 1e-6); ok || math.Abs(x-1) > 1e-15 {
+		t.Errorf("brent(step, 0, 3) = %v, %v, want 1, false", x, ok)
+	}
+
+	// Agree with the closed-form InvCDF.
+	for _, p := range []float64{0.001, 0.3, 0.5, 0.9, 0.999999} {
+		want := StdNormal.InvCDF(p)
+		f := func(x float64) float64 { return StdNormal.CDF(x) - p }
+		if x, ok := brent(f, -10, 10, 1e-15); !ok || math.Abs(x-want) > 1e-8*math.Max(1, math.Abs(want)) {
+			t.Errorf("brent(StdNormal.CDF-%v) = %v, %v, want %v, true", p, x, ok, want)
+		}
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("brent with unbracketed root did not panic")
+			}
+		}()
+		brent(cubic, 3, 4, 1e-14)
+	}()
+}
+
+func TestNewton(t *testing.T) {
+	const root = 2.0945514815423265
+	cubic := func(x float64) float64 { return x*x*x - 2*x - 5 }
+	dcubic := func(x float64) float64 { return 3*x*x - 2 }
+	if x, ok := newton(cubic, dcubic, 2, 1e-14, 50); !ok || math.Abs(x-root) > 1e-14 {
+		t.Errorf("newton(x³-2x-5, 2) = %v, %v, want %v, true", x, ok, root)
+	}
+	// Newton's method converges quadratically, so a handful of
+	// steps is enough.
+	if x, ok := newton(cubic, dcubic, 2, 1e-14, 5); !ok || math.Abs(x-root) > 1e-14 {
+		t.Errorf("newton(x³-2x-5, 2, maxIter=5) = %v, %v, want %v, true", x, ok, root)
+	}
+	// But not in a single step.
+	if _, ok := newton(cubic, dcubic, 2, 1e-14, 1); ok {
+		t.Errorf("newton(x³-2x-5, 2, maxIter=1) converged")
+	}
+
+	// Invert the normal CDF using the PDF as the derivative.
+	for _, p := range []float64{0.001, 0.3, 0.5, 0.9, 0.999} {
+		want := StdNormal.InvCDF(p)
+		f := func(x float64) float64 { return StdNormal.CDF(x) - p }
+		if x, ok := newton(f, StdNormal.PDF, 0, 1e-15, 50); !ok || math.Abs(x-want) > 1e-8 {
+			t.Errorf("newton(StdNormal.CDF-%v) = %v, %v, want %v, true", p, x, ok, want)
+		}
+	}
+
+	// Starting in a flat region falls back.
+	flat := func(x float64) float64 {
+		if x < 1 {
+			return -1
+		}
+		return x - 2
+	}
+	dflat := func(x float64) float64 {
+		if x < 1 {
+			return 0
+		}
+		return 1
+	}
+	if x, ok := newton(flat, dflat, 0, 1e-10, 50); ok || x != 0 {
+		t.Errorf("newton(flat, 0) = %v, %v, want 0, false", x, ok)
+	}
+	// So does an f with no root, where Newton's method cycles.
+	noRoot := func(x float64) float64 { return x*x + 1 }
+	dnoRoot := func(x float64) float64 { return 2 * x }
+	if _, ok := newton(noRoot, dnoRoot, 0.5, 1e-10, 100); ok {
+		t.Errorf("newton(x²+1) converged")
+	}
+}
+
+func TestSeries(t *testing.T) {
+	// A rapidly converging series.
+	if got := series(func(n float64) float64 { return math.Pow(0.5, n) }); got != 2 {
+		t.Errorf("Σ 2⁻ⁿ = %v, want 2", got)
+	}
+	if got := series(func(n float64) float64 { return 0 }); got != 0 {
+		t.Errorf("Σ 0 = %v, want 0", got)
+	}
+
+	// A slowly converging geometric series is cut off at
+	// maxSeriesTerms, giving a known partial sum.
+	const d = 1.1920928955078125e-07 // 2^-23
+	const r = 1 - d
+	want := -math.Expm1(maxSeriesTerms*math.Log1p(-d)) / d
+	if got := series(func(n float64) float64 { return math.Pow(r, n) }); math.Abs(got-want) > 1e-11*want {
+		t.Errorf("Σ rⁿ = %v, want partial sum %v", got, want)
+	}
+
+	// Compensated summation keeps round-off from accumulating over
+	// many terms.
+	if got, want := series(func(n float64) float64 { return 0.1 }), 0.1*maxSeriesTerms; math.Abs(got-want) > 1e-16*want {
+		t.Errorf("Σ 0.1 = %v, want %v", got, want)
+	}
+
+	// The divergent harmonic series must terminate.
+	h := series(func(n float64) float64 { return 1 / (n + 1) })
+	// H_N ≈ ln N + γ + 1/2N.
+	N := float64(maxSeriesTerms)
+	if want := math.Log(N) + 0.57721566490153286 + 1/(2*N); math.Abs(h-want) > 1e-12 {
+		t.Errorf("harmonic partial sum = %v, want %v", h, want)
+	}
+}
+
+func TestAitken(t *testing.T) {
+	// The fixed point of cos, the Dottie number. Plain fixed-point
+	// iteration converges linearly with rate sin(x) ≈ 0.67, so it
+	// needs about 90 iterations to reach 1e-15.
+	const dottie = 0.7390851332151607
+	evals := 0
+	cos := func(x float64) float64 {
+		evals++
+		return math.Cos(x)
+	}
+	x, ok := aitken(cos, 1, 1e-15, 100)
+	if !ok || math.Abs(x-dottie) > 1e-15 {
+		t.Errorf("aitken(cos, 1) = %v, %v, want %v, true", x, ok, dottie)
+	}
+	if evals > 20 {
+		t.Errorf("aitken(cos, 1) took %d evaluations, want <= 20", evals)
+	}
+
+	// x - (x² - 2)/4 converges to √2 linearly.
+	slow := func(x float64) float64 { return x - (x*x-2)/4 }
+	if x, ok := aitken(slow, 1, 1e-15, 100); !ok || math.Abs(x-math.Sqrt2) > 1e-15 {
+		t.Errorf("aitken(slow √2) = %v, %v, want %v, true", x, ok, math.Sqrt2)
+	}
+
+	// An exact fixed point.
+	if x, ok := aitken(func(x float64) float64 { return 3 }, 0, 0, 10); !ok || x != 3 {
+		t.Errorf("aitken(const 3) = %v, %v, want 3, true", x, ok)
+	}
+
+	// An iteration with no fixed point fails.
+	if _, ok := aitken(func(x float64) float64 { return x + 1 }, 0, 1e-12, 50); ok {
+		t.Errorf("aitken(x+1) converged")
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	check := func(name string, x, fx, wantX, wantF, tol float64) {
+		t.Helper()
+		if math.Abs(x-wantX) > tol || math.Abs(fx-wantF) > tol {
+			t.Errorf("%s = %v, %v, want %v, %v", name, x, fx, wantX, wantF)
+		}
+	}
+
+	parabola := func(x float64) float64 { return 2*(x-1.5)*(x-1.5) - 3 }
+	x, fx := minimize(parabola, -10, 10, 1e-10)
+	check("minimize(parabola, -10, 10)", x, fx, 1.5, -3, 1e-8)
+	x, fx = minimize(parabola, 10, -10, 1e-10)
+	check("minimize(parabola, 10, -10)", x, fx, 1.5, -3, 1e-8)
+	// Minimum at an endpoint.
+	x, fx = minimize(parabola, 2, 5, 1e-10)
+	check("minimize(parabola, 2, 5)", x, fx, 2, parabola(2), 1e-6)
+
+	// The mode of a beta distribution is (α-1)/(α+β-2).
+	for _, d := range []BetaDist{{2, 5}, {3, 3}, {10, 2}} {
+		neg := func(x float64) float64 { return -d.PDF(x) }
+		want := (d.Alpha - 1) / (d.Alpha + d.Beta - 2)
+		x, fx := minimize(neg, 0, 1, 1e-10)
+		check(fmt.Sprintf("minimize(-%+v.PDF)", d), x, fx, want, -d.PDF(want), 1e-7)
+	}
+}
+
+func TestDerivative(t *testing.T) {
+	if got := derivative(math.Sin, 0, 1e-5); math.Abs(got-1) > 1e-9 {
+		t.Errorf("derivative(sin, 0) = %v, want 1", got)
+	}
+	if got := derivative(math.Exp, 1, 1e-5); math.Abs(got-math.E) > 1e-9 {
+		t.Errorf("derivative(exp, 1) = %v, want %v", got, math.E)
+	}
+
+	check := func(name string, got, want float64) {
+		t.Helper()
+		if math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+	check("derivativeRichardson(sin, 0)", derivativeRichardson(math.Sin, 0), 1)
+	check("derivativeRichardson(exp, 1)", derivativeRichardson(math.Exp, 1), math.E)
+	check("derivativeRichardson(exp, 10)", derivativeRichardson(math.Exp, 10), math.Exp(10))
+	check("derivativeRichardson(x³, -2)", derivativeRichardson(func(x float64) float64 { return x * x * x }, -2), 12)
+
+	// Recover a PDF from a CDF.
+	for _, d := range []Dist{StdNormal, GammaDist{Shape: 3, Scale: 2}} {
+		for _, x := range []float64{0.5, 1, 4} {
+			check(fmt.Sprintf("derivativeRichardson(%+v.CDF, %v)", d, x), derivativeRichardson(d.CDF, x), d.PDF(x))
+		}
+	}
+}
+
+func TestIntegrate(t *testing.T) {
+	check := func(name string, got, want, tol float64) {
+		t.Helper()
+		if math.Abs(got-want) > tol {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+	// Simpson's rule is exact for cubics.
+	cubic := func(x float64) float64 { return x*x*x - x + 1 }
+	check("∫x³-x+1 over [0, 2]", integrate(cubic, 0, 2, 2), 4, 1e-14)
+	check("∫x³-x+1 over [2, 0]", integrate(cubic, 2, 0, 2), -4, 1e-14)
+	// Odd n is rounded up.
+	check("∫x³-x+1 over [0, 2], n=3", integrate(cubic, 0, 2, 3), 4, 1e-14)
+	check("∫x³-x+1 over [0, 2], n=0", integrate(cubic, 0, 2, 0), 4, 1e-14)
+
+	check("∫sin over [0, π]", integrate(math.Sin, 0, math.Pi, 100), 2, 1e-7)
+	check("∫StdNormal.PDF over [-5, 5]", integrate(StdNormal.PDF, -5, 5, 100), 1, 1e-6)
+	check("∫StdNormal.PDF over [5, -5]", integrate(StdNormal.PDF, 5, -5, 100), -1, 1e-6)
+	check("∫x² over [1, 1]", integrate(func(x float64) float64 { return x * x }, 1, 1, 10), 0, 0)
+}
+
+func TestIntegrateAdaptive(t *testing.T) {
+	check := func(name string, got, want float64) {
+		t.Helper()
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+	check("∫sin over [0, π]", integrateAdaptive(math.Sin, 0, math.Pi, 1e-12), 2)
+	check("∫x² over [0, 3]", integrateAdaptive(func(x float64) float64 { return x * x }, 0, 3, 1e-12), 9)
+	check("∫√x over [0, 1]", integrateAdaptive(math.Sqrt, 0, 1, 1e-12), 2.0/3)
+	check("∫x² over [3, 0]", integrateAdaptive(func(x float64) float64 { return x * x }, 3, 0, 1e-12), -9)
+	check("∫StdNormal.PDF over [-5, 5]", integrateAdaptive(StdNormal.PDF, -5, 5, 1e-12), 1-2*StdNormal.CDF(-5))
+
+	// Non-finite integrands must terminate.
+	step := func(x float64) float64 {
+		if x > 1 {
+			return inf
+		}
+		return 1
+	}
+	if got := integrateAdaptive(step, 0, 2, 1e-12); !math.IsInf(got, 1) {
+		t.Errorf("∫step over [0, 2] = %v, want +Inf", got)
+	}
+	nanf := func(x float64) float64 { return nan }
+	if got := integrateAdaptive(nanf, 0, 1, 1e-12); !math.IsNaN(got) {
+		t.Errorf("∫NaN over [0, 1] = %v, want NaN", got)
+	}
+
+	// Integrands that never converge must also terminate.
+	noisy := func(x float64) float64 { return math.Sin(1 / x) }
+	integrateAdaptive(noisy, 1e-300, 1, 0)
+}
+
+func benchmarkRootFinder(b *testing.B, find func(f func(float64) float64, low, high, tolerance float64) (float64, bool)) {
+	ps := []float64{0.001, 0.05, 0.3, 0.5, 0.7, 0.95, 0.999}
+	for i := 0; i < b.N; i++ {
+		p := ps[i%len(ps)]
+		find(func(x float64) float64 { return StdNormal.CDF(x) - p }, -10, 10, 1e-12)
+	}
+}
+
+func BenchmarkBisectNormalInvCDF(b *testing.B) {
+	benchmarkRootFinder(b, bisect)
+}
+
+func BenchmarkBrentNormalInvCDF(b *testing.B) {
+	benchmarkRootFinder(b, brent)
+}
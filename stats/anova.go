@@ -0,0 +1,90 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+// An ANOVAResult is the result of a one-way analysis of variance.
+type ANOVAResult struct {
+	// F is the F statistic, the ratio of the between-group mean
+	// square to the within-group mean square.
+	F float64
+
+	// DFBetween and DFWithin are the between-group and
+	// within-group degrees of freedom. These are k-1 and N-k for
+	// k groups and N total values.
+	DFBetween, DFWithin int
+
+	// SSBetween and SSWithin are the between-group and
+	// within-group sums of squares.
+	SSBetween, SSWithin float64
+
+	// GroupMeans are the means of each group, in the order the
+	// groups were given.
+	GroupMeans []float64
+
+	// GrandMean is the mean of all values across all groups.
+	GrandMean float64
+
+	// P is the p-value of the test for the null hypothesis that
+	// all groups have the same mean.
+	P float64
+}
+
+// OneWayANOVA performs a one-way analysis of variance of the null
+// hypothesis that groups are all drawn from populations with the same
+// mean. It assumes the populations are normally distributed with
+// equal variance. With two groups, this is equivalent to
+// TwoSampleTTest, with F equal to the square of the t statistic.
+//
+// This can fail with ErrSampleSize if there are fewer than two groups,
+// any group is empty, or there are no within-group degrees of freedom,
+// or ErrZeroVariance if all groups have zero variance.
+func OneWayANOVA(groups ...[]float64) (*ANOVAResult, error) {
+	k := len(groups)
+	if k < 2 {
+		return nil, ErrSampleSize
+	}
+	n, sum := 0, 0.0
+	means := make([]float64, k)
+	for i, g := range groups {
+		if len(g) == 0 {
+			return nil, ErrSampleSize
+		}
+		means[i] = Mean(g)
+		n += len(g)
+		for _, x := range g {
+			sum += x
+		}
+	}
+	if n == k {
+		return nil, ErrSampleSize
+	}
+	grand := sum / float64(n)
+
+	var ssb, ssw float64
+	for i, g := range groups {
+		d := means[i] - grand
+		ssb += float64(len(g)) * d * d
+		for _, x := range g {
+			ssw += (x - means[i]) * (x - means[i])
+		}
+	}
+	if ssw == 0 {
+		return nil, ErrZeroVariance
+	}
+
+	dfb, dfw := k-1, n-k
+	f := (ssb / float64(dfb)) / (ssw / float64(dfw))
+	p := 1 - FDist{float64(dfb), float64(dfw)}.CDF(f)
+	return &ANOVAResult{
+		F:          f,
+		DFBetween:  dfb,
+		DFWithin:   dfw,
+		SSBetween:  ssb,
+		SSWithin:   ssw,
+		GroupMeans: means,
+		GrandMean:  grand,
+		P:          p,
+	}, nil
+}
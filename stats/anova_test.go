@@ -0,0 +1,55 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "testing"
+
+func TestOneWayANOVA(t *testing.T) {
+	res, err := OneWayANOVA(
+		[]float64{6, 8, 4, 5, 3, 4},
+		[]float64{8, 12, 9, 11, 6, 8},
+		[]float64{13, 9, 11, 8, 7, 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.DFBetween != 2 || res.DFWithin != 15 ||
+		!aeq(res.SSBetween, 84) || !aeq(res.SSWithin, 68) ||
+		!aeq(res.F, 9.264705882352942) || !aeq(res.P, 0.002398777329392908) {
+		t.Errorf("OneWayANOVA = %+v", res)
+	}
+	if !aeq(res.GrandMean, 8) || len(res.GroupMeans) != 3 ||
+		!aeq(res.GroupMeans[0], 5) || !aeq(res.GroupMeans[1], 9) || !aeq(res.GroupMeans[2], 10) {
+		t.Errorf("OneWayANOVA means = %v, %v, want 8, [5 9 10]", res.GrandMean, res.GroupMeans)
+	}
+
+	// With two groups, F = t² and the p-values agree.
+	x1 := []float64{1, 2, 4, 4, 7, 9}
+	x2 := []float64{3, 5, 6, 8, 10, 11, 12}
+	res, err = OneWayANOVA(x1, x2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tt, err := TwoSampleTTest(Sample{Xs: x1}, Sample{Xs: x2}, LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aeq(res.F, tt.T*tt.T) || !aeq(res.P, tt.P) {
+		t.Errorf("OneWayANOVA F=%v P=%v, want t²=%v P=%v", res.F, res.P, tt.T*tt.T, tt.P)
+	}
+
+	for _, groups := range [][][]float64{
+		nil,
+		{{1, 2}},
+		{{1, 2}, {}},
+		{{1}, {2}},
+	} {
+		if _, err := OneWayANOVA(groups...); err != ErrSampleSize {
+			t.Errorf("OneWayANOVA(%v) returned error %v, want ErrSampleSize", groups, err)
+		}
+	}
+	if _, err := OneWayANOVA([]float64{1, 1}, []float64{2, 2}); err != ErrZeroVariance {
+		t.Errorf("OneWayANOVA with zero variance returned error %v, want ErrZeroVariance", err)
+	}
+}
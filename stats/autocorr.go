@@ -0,0 +1,98 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// Autocorrelation returns the sample autocorrelation of the series xs
+// at lags 0 through maxLag. The autocorrelation at lag k is
+//
+//	r_k = Σ_t (x_t - x̄)(x_(t+k) - x̄) / Σ_t (x_t - x̄)²
+//
+// so r_0 is 1. This is the biased estimator used by most time series
+// software: it has lower variance than the unbiased estimator and
+// guarantees the sequence of autocorrelations is positive
+// semi-definite. See AutocorrelationUnbiased.
+//
+// maxLag is capped to len(xs)-1. If xs is empty, Autocorrelation
+// returns nil. If all values in xs are equal, the autocorrelation is
+// undefined and every element of the result is NaN.
+func Autocorrelation(xs []float64, maxLag int) []float64 {
+	return autocorrelation(xs, maxLag, false)
+}
+
+// AutocorrelationUnbiased is like Autocorrelation, but scales the
+// lag-k autocovariance by 1/(n-k) rather than 1/n, so
+//
+//	r_k = n/(n-k) · Σ_t (x_t - x̄)(x_(t+k) - x̄) / Σ_t (x_t - x̄)²
+//
+// This removes the bias toward 0 at large lags, at the cost of higher
+// variance.
+func AutocorrelationUnbiased(xs []float64, maxLag int) []float64 {
+	return autocorrelation(xs, maxLag, true)
+}
+
+func autocorrelation(xs []float64, maxLag int, unbiased bool) []float64 {
+	n := len(xs)
+	if n == 0 || maxLag < 0 {
+		return nil
+	}
+	maxLag = minint(maxLag, n-1)
+
+	mean := Mean(xs)
+	dev := make([]float64, n)
+	c0 := 0.0
+	for i, x := range xs {
+		dev[i] = x - mean
+		c0 += dev[i] * dev[i]
+	}
+	out := make([]float64, maxLag+1)
+	if c0 == 0 {
+		for k := range out {
+			out[k] = math.NaN()
+		}
+		return out
+	}
+	for k := range out {
+		ck := 0.0
+		for t := 0; t+k < n; t++ {
+			ck += dev[t] * dev[t+k]
+		}
+		out[k] = ck / c0
+		if unbiased {
+			out[k] *= float64(n) / float64(n-k)
+		}
+	}
+	return out
+}
+
+// DurbinWatson returns the Durbin-Watson statistic of a series of
+// regression residuals,
+//
+//	d = Σ_t (e_t - e_(t-1))² / Σ_t e_t²
+//
+// d lies in [0, 4] and is approximately 2(1 - r_1), where r_1 is the
+// lag-1 autocorrelation of the residuals. Values near 2 indicate no
+// autocorrelation, values toward 0 indicate positive
+// autocorrelation, and values toward 4 indicate negative
+// autocorrelation.
+//
+// If residuals has fewer than 2 values or they are all 0,
+// DurbinWatson returns NaN.
+func DurbinWatson(residuals []float64) float64 {
+	if len(residuals) < 2 {
+		return math.NaN()
+	}
+	num, den := 0.0, residuals[0]*residuals[0]
+	for t := 1; t < len(residuals); t++ {
+		d := residuals[t] - residuals[t-1]
+		num += d * d
+		den += residuals[t] * residuals[t]
+	}
+	if den == 0 {
+		return math.NaN()
+	}
+	return num / den
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestAutocorrelation(t *testing.T) {
+	// A period-4 sequence, by hand: the deviations from the mean
+	// are [-1, 0, 1, 0] repeated, so c_0 = 4, c_1 = 0, c_2 = -3,
+	// and c_3 = 0 (over 8 values).
+	xs := []float64{1, 2, 3, 2, 1, 2, 3, 2}
+	got := Autocorrelation(xs, 3)
+	want := []float64{1, 0, -0.75, 0}
+	for k := range want {
+		if math.Abs(got[k]-want[k]) > 1e-12 {
+			t.Errorf("Autocorrelation(%v, 3) = %v, want %v", xs, got, want)
+			break
+		}
+	}
+	got = AutocorrelationUnbiased(xs, 3)
+	want = []float64{1, 0, -1, 0}
+	for k := range want {
+		if math.Abs(got[k]-want[k]) > 1e-12 {
+			t.Errorf("AutocorrelationUnbiased(%v, 3) = %v, want %v", xs, got, want)
+			break
+		}
+	}
+
+	if got := Autocorrelation(xs, 100); len(got) != len(xs) {
+		t.Errorf("Autocorrelation(%v, 100) has %d lags, want %d", xs, len(got), len(xs))
+	}
+	if got := Autocorrelation(nil, 3); got != nil {
+		t.Errorf("Autocorrelation(nil, 3) = %v, want nil", got)
+	}
+	for _, r := range Autocorrelation([]float64{2, 2, 2}, 2) {
+		if !math.IsNaN(r) {
+			t.Errorf("Autocorrelation of constant series = %v, want NaN", r)
+		}
+	}
+}
+
+func TestAutocorrelationSeries(t *testing.T) {
+	const n = 20000
+	r := rand.New(rand.NewSource(1))
+
+	// White noise is uncorrelated beyond lag 0. The standard
+	// error of each r_k is about 1/√n.
+	noise := SampleN(StdNormal, n, r)
+	acf := Autocorrelation(noise, 10)
+	if acf[0] != 1 {
+		t.Errorf("white noise r_0 = %v, want 1", acf[0])
+	}
+	for k := 1; k <= 10; k++ {
+		if math.Abs(acf[k]) > 4/math.Sqrt(n) {
+			t.Errorf("white noise r_%d = %v, want ~0", k, acf[k])
+		}
+	}
+
+	// An AR(1) series x_t = φx_(t-1) + ε_t has autocorrelation φ^k.
+	const φ = 0.7
+	ar := make([]float64, n)
+	for i := 1; i < n; i++ {
+		ar[i] = φ*ar[i-1] + noise[i]
+	}
+	acf = Autocorrelation(ar, 10)
+	for k := 0; k <= 10; k++ {
+		if want := math.Pow(φ, float64(k)); math.Abs(acf[k]-want) > 0.03 {
+			t.Errorf("AR(1) r_%d = %v, want ~%v", k, acf[k], want)
+		}
+	}
+}
+
+func TestDurbinWatson(t *testing.T) {
+	// Alternating residuals are perfectly negatively
+	// autocorrelated: Σ(e_t - e_(t-1))² = 3·4 and Σe_t² = 4.
+	if got := DurbinWatson([]float64{1, -1, 1, -1}); got != 3 {
+		t.Errorf("DurbinWatson of alternating residuals = %v, want 3", got)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	const n = 1000
+	indep := make([]float64, n)
+	walk := make([]float64, n)
+	for i := range indep {
+		indep[i] = r.NormFloat64()
+		walk[i] = indep[i]
+		if i > 0 {
+			walk[i] += walk[i-1]
+		}
+	}
+	// Independent residuals should be near 2.
+	if got := DurbinWatson(indep); math.Abs(got-2) > 0.15 {
+		t.Errorf("DurbinWatson of independent residuals = %v, want ~2", got)
+	}
+	// A random walk is strongly positively autocorrelated.
+	if got := DurbinWatson(walk); got > 0.05 {
+		t.Errorf("DurbinWatson of random walk = %v, want ~0", got)
+	}
+	// Residuals of a linear fit with AR(1) noise.
+	x := make([]float64, n)
+	y := make([]float64, n)
+	e := 0.0
+	for i := range x {
+		e = 0.9*e + r.NormFloat64()
+		x[i] = float64(i)
+		y[i] = 3 + 0.5*x[i] + e
+	}
+	lr, err := LinearRegression(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := make([]float64, n)
+	for i := range res {
+		res[i] = y[i] - lr.Predict(x[i])
+	}
+	if got, want := DurbinWatson(res), 2*(1-0.9); math.Abs(got-want) > 0.1 {
+		t.Errorf("DurbinWatson of AR(1) residuals = %v, want ~%v", got, want)
+	}
+
+	for _, es := range [][]float64{nil, {1}, {0, 0, 0}} {
+		if got := DurbinWatson(es); !math.IsNaN(got) {
+			t.Errorf("DurbinWatson(%v) = %v, want NaN", es, got)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// BernoulliDist is a Bernoulli distribution. This is the distribution
+// of a single trial that succeeds (X=1) with probability P and fails
+// (X=0) with probability 1-P.
+//
+// This is equivalent to BinomialDist{N: 1, P: P}.
+type BernoulliDist struct {
+	// P is the probability of success. 0 <= P <= 1.
+	P float64
+}
+
+// PMF is the probability of outcome int(k).
+func (d BernoulliDist) PMF(k float64) float64 {
+	switch math.Floor(k) {
+	case 0:
+		return 1 - d.P
+	case 1:
+		return d.P
+	}
+	return 0
+}
+
+func (d BernoulliDist) CDF(k float64) float64 {
+	if k < 0 {
+		return 0
+	} else if k < 1 {
+		return 1 - d.P
+	}
+	return 1
+}
+
+func (d BernoulliDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	if r == nil {
+		u = rand.Float64()
+	} else {
+		u = r.Float64()
+	}
+	if u < d.P {
+		return 1
+	}
+	return 0
+}
+
+func (d BernoulliDist) Bounds() (float64, float64) {
+	return 0, 1
+}
+
+func (d BernoulliDist) Step() float64 {
+	return 1
+}
+
+func (d BernoulliDist) Mean() float64 {
+	return d.P
+}
+
+func (d BernoulliDist) Variance() float64 {
+	return d.P * (1 - d.P)
+}
+
+// Mode returns the mode of d. If P = 0.5, both 0 and 1 are modes and
+// Mode returns 0.
+func (d BernoulliDist) Mode() float64 {
+	if d.P > 0.5 {
+		return 1
+	}
+	return 0
+}
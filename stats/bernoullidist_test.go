@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBernoulliDist(t *testing.T) {
+	dist := BernoulliDist{P: 0.3}
+	testFunc(t, fmt.Sprintf("%+v.PMF", dist), dist.PMF,
+		map[float64]float64{
+			-1:   0,
+			-0.5: 0,
+			0:    0.7,
+			0.5:  0.7,
+			1:    0.3,
+			1.5:  0.3,
+			2:    0,
+		})
+	testDiscreteCDF(t, fmt.Sprintf("%+v.CDF", dist), dist)
+
+	binom := BinomialDist{N: 1, P: dist.P}
+	for _, k := range []float64{-1, 0, 0.5, 1, 2} {
+		if a, b := dist.PMF(k), binom.PMF(k); !aeq(a, b) {
+			t.Errorf("%+v.PMF(%v) = %v, but %+v.PMF(%v) = %v", dist, k, a, binom, k, b)
+		}
+		if a, b := dist.CDF(k), binom.CDF(k); !aeq(a, b) {
+			t.Errorf("%+v.CDF(%v) = %v, but %+v.CDF(%v) = %v", dist, k, a, binom, k, b)
+		}
+	}
+	if m, v := dist.Mean(), dist.Variance(); m != 0.3 || !aeq(v, 0.21) {
+		t.Errorf("%+v mean, variance = %v, %v; want 0.3, 0.21", dist, m, v)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/jgbaldwinbrown/go-moremath/mathx"
+)
+
+// BetaDist is a beta distribution with shape parameters Alpha and
+// Beta. It has support [0, 1].
+//
+// The beta distribution is the conjugate prior of the Bernoulli and
+// binomial distributions: given a BetaDist prior, observing s
+// successes and f failures yields the posterior
+// BetaDist{Alpha + s, Beta + f}.
+type BetaDist struct {
+	// Alpha and Beta are the shape parameters. Alpha > 0 and
+	// Beta > 0. If Alpha < 1 (resp. Beta < 1), the PDF diverges
+	// at 0 (resp. 1).
+	Alpha, Beta float64
+}
+
+func (d BetaDist) PDF(x float64) float64 {
+	if x < 0 || x > 1 {
+		return 0
+	}
+	if x == 0 {
+		return d.boundaryPDF(d.Alpha, d.Beta)
+	} else if x == 1 {
+		return d.boundaryPDF(d.Beta, d.Alpha)
+	}
+	return math.Exp((d.Alpha-1)*math.Log(x) + (d.Beta-1)*math.Log1p(-x) -
+		lgamma(d.Alpha) - lgamma(d.Beta) + lgamma(d.Alpha+d.Beta))
+}
+
+// boundaryPDF returns the PDF at the boundary where the shape
+// parameter a applies, given the opposite shape parameter b.
+func (BetaDist) boundaryPDF(a, b float64) float64 {
+	switch {
+	case a < 1:
+		return inf
+	case a == 1:
+		// 1/B(1, b) = b
+		return b
+	default:
+		return 0
+	}
+}
+
+func (d BetaDist) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	} else if x >= 1 {
+		return 1
+	}
+	return mathx.BetaInc(x, d.Alpha, d.Beta)
+}
+
+func (d BetaDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 {
+		return nan
+	} else if p == 0 {
+		return 0
+	} else if p == 1 {
+		return 1
+	}
+	x, _ = bisect(func(x float64) float64 {
+		return d.CDF(x) - p
+	}, 0, 1, 1e-15)
+	return x
+}
+
+// Rand returns a random sample drawn from d. This uses the fact that
+// if X ~ Gamma(Alpha, 1) and Y ~ Gamma(Beta, 1), then X/(X+Y) ~
+// Beta(Alpha, Beta).
+func (d BetaDist) Rand(r *rand.Rand) float64 {
+	x := GammaDist{Shape: d.Alpha, Scale: 1}.Rand(r)
+	y := GammaDist{Shape: d.Beta, Scale: 1}.Rand(r)
+	return x / (x + y)
+}
+
+func (d BetaDist) Bounds() (float64, float64) {
+	return 0, 1
+}
+
+func (d BetaDist) Mean() float64 {
+	return d.Alpha / (d.Alpha + d.Beta)
+}
+
+func (d BetaDist) Variance() float64 {
+	ab := d.Alpha + d.Beta
+	return d.Alpha * d.Beta / (ab * ab * (ab + 1))
+}
+
+// Mode returns the mode of d. If Alpha > 1 and Beta > 1, this is
+// the unique interior mode. Otherwise, the density is maximized at an
+// endpoint: if Alpha < 1 and Beta < 1, the density is bimodal with
+// modes at both 0 and 1 and Mode returns 0; if Alpha = Beta = 1, the
+// distribution is uniform and Mode returns 0.5.
+func (d BetaDist) Mode() float64 {
+	a, b := d.Alpha, d.Beta
+	switch {
+	case a > 1 && b > 1:
+		return (a - 1) / (a + b - 2)
+	case a == 1 && b == 1:
+		return 0.5
+	case a < 1 || (a == 1 && b > 1):
+		return 0
+	}
+	return 1
+}
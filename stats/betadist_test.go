@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestBetaDist(t *testing.T) {
+	d := BetaDist{Alpha: 2, Beta: 3}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-0.1: 0,
+		0:    0,
+		0.25: 12 * 0.25 * 0.75 * 0.75,
+		0.5:  12 * 0.5 * 0.5 * 0.5,
+		1:    0,
+		1.1:  0,
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-0.1: 0,
+		0:    0,
+		0.5:  0.6875,
+		1:    1,
+		1.1:  1,
+	})
+	testPDFIntegral(t, d, vec.Linspace(0, 1, 11))
+	testInvCDF(t, d, true)
+
+	if m := d.Mean(); !aeq(m, 0.4) {
+		t.Errorf("%+v.Mean() = %v, want 0.4", d, m)
+	}
+	if v := d.Variance(); !aeq(v, 0.04) {
+		t.Errorf("%+v.Variance() = %v, want 0.04", d, v)
+	}
+}
+
+func TestBetaDistBoundaries(t *testing.T) {
+	// Alpha, Beta < 1 diverges at both boundaries.
+	d := BetaDist{Alpha: 0.5, Beta: 0.5}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-0.1: 0,
+		0:    inf,
+		0.5:  2 / math.Pi,
+		1:    inf,
+		1.1:  0,
+	})
+	// This is the arcsine distribution.
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		0:    0,
+		0.25: 2 / math.Pi * math.Asin(0.5),
+		0.5:  0.5,
+		1:    1,
+	})
+	testPDFIntegral(t, d, vec.Linspace(0.05, 0.95, 10))
+	testInvCDF(t, d, true)
+
+	// Divergence at only one boundary.
+	d = BetaDist{Alpha: 0.5, Beta: 2}
+	if got := d.PDF(0); !math.IsInf(got, 1) {
+		t.Errorf("%+v.PDF(0) = %v, want +Inf", d, got)
+	}
+	if got := d.PDF(1); got != 0 {
+		t.Errorf("%+v.PDF(1) = %v, want 0", d, got)
+	}
+	testInvCDF(t, d, true)
+
+	// Alpha = 1 has a finite, non-zero density at 0.
+	d = BetaDist{Alpha: 1, Beta: 3}
+	if got := d.PDF(0); got != 3 {
+		t.Errorf("%+v.PDF(0) = %v, want 3", d, got)
+	}
+}
+
+func TestBetaDistRand(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := BetaDist{Alpha: 0.5, Beta: 3}
+	xs := make([]float64, 100000)
+	for i := range xs {
+		xs[i] = d.Rand(r)
+		if xs[i] < 0 || xs[i] > 1 {
+			t.Fatalf("%+v.Rand() = %v, out of support", d, xs[i])
+		}
+	}
+	if m, want := Mean(xs), d.Mean(); math.Abs(m-want) > 0.02*want {
+		t.Errorf("%+v: sample mean %v, want %v", d, m, want)
+	}
+}
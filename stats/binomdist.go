@@ -6,6 +6,7 @@ package stats
 
 import (
 	"math"
+	"math/rand"
 
 	"github.com/jgbaldwinbrown/go-moremath/mathx"
 )
@@ -28,7 +29,13 @@ func (d BinomialDist) PMF(k float64) float64 {
 	if ki < 0 || ki > d.N {
 		return 0
 	}
-	return mathx.Choose(d.N, ki) * math.Pow(d.P, float64(ki)) * math.Pow(1-d.P, float64(d.N-ki))
+	pmf := mathx.Choose(d.N, ki) * math.Pow(d.P, float64(ki)) * math.Pow(1-d.P, float64(d.N-ki))
+	if math.IsNaN(pmf) || math.IsInf(pmf, 0) {
+		// The binomial coefficient overflowed. Compute in log
+		// space instead.
+		pmf = math.Exp(mathx.Lchoose(d.N, ki) + float64(ki)*math.Log(d.P) + float64(d.N-ki)*math.Log1p(-d.P))
+	}
+	return pmf
 }
 
 // CDF is the probability of getting k or fewer successes in d.N
@@ -45,6 +52,49 @@ func (d BinomialDist) CDF(k float64) float64 {
 	return mathx.BetaInc(1-d.P, float64(d.N-ki), k+1)
 }
 
+// InvCDF returns the smallest integer k such that d.CDF(k) >= p.
+// This uses a binary search over the support, so it takes O(log N)
+// evaluations of the CDF.
+func (d BinomialDist) InvCDF(p float64) (k float64) {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return nan
+	} else if p == 0 {
+		return 0
+	} else if p == 1 {
+		return float64(d.N)
+	}
+	// Invariant: CDF(lo-1) < p <= CDF(hi).
+	lo, hi := 0, d.N
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if d.CDF(float64(mid)) < p {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return float64(lo)
+}
+
+// Rand returns a random sample drawn from d. For small N, this counts
+// successes in N Bernoulli trials. Otherwise, it inverts the CDF.
+func (d BinomialDist) Rand(r *rand.Rand) float64 {
+	unif := rand.Float64
+	if r != nil {
+		unif = r.Float64
+	}
+	if d.N <= 16 {
+		k := 0
+		for i := 0; i < d.N; i++ {
+			if unif() < d.P {
+				k++
+			}
+		}
+		return float64(k)
+	}
+	return float64(invDiscreteCDF(d.CDF, unif(), 0, d.N))
+}
+
 func (d BinomialDist) Bounds() (float64, float64) {
 	return 0, float64(d.N)
 }
@@ -61,6 +111,25 @@ func (d BinomialDist) Variance() float64 {
 	return float64(d.N) * d.P * (1 - d.P)
 }
 
+// Mode returns the mode of d. If (N+1)P is an integer between 1 and
+// N, both (N+1)P and (N+1)P-1 are modes and Mode returns the larger.
+func (d BinomialDist) Mode() float64 {
+	return math.Min(math.Floor(float64(d.N+1)*d.P), float64(d.N))
+}
+
+func (d BinomialDist) Skewness() float64 {
+	return (1 - 2*d.P) / math.Sqrt(d.Variance())
+}
+
+func (d BinomialDist) ExcessKurtosis() float64 {
+	return (1 - 6*d.P*(1-d.P)) / d.Variance()
+}
+
+// Entropy returns the Shannon entropy of d in nats.
+func (d BinomialDist) Entropy() float64 {
+	return discreteEntropy(d)
+}
+
 // NormalApprox returns a normal distribution approximation of
 // binomial distribution d.
 //
@@ -43,3 +43,57 @@ func TestBinomialDist(t *testing.T) {
 		}
 	}
 }
+
+func TestBinomialDistInvCDF(t *testing.T) {
+	for _, dist := range []BinomialDist{{5, 0.2}, {30, 0.5}, {1000, 0.01}, {1, 0.5}} {
+		testFunc(t, fmt.Sprintf("%+v.InvCDF", dist), dist.InvCDF,
+			map[float64]float64{
+				-0.1: nan,
+				0:    0,
+				1:    float64(dist.N),
+				1.1:  nan,
+			})
+		for k := 0; k <= dist.N; k++ {
+			if dist.PMF(float64(k)) < 1e-12 {
+				// The CDF is flat to within round-off.
+				continue
+			}
+			cdf := dist.CDF(float64(k))
+			if got := dist.InvCDF(cdf); got != float64(k) {
+				t.Errorf("%+v.InvCDF(CDF(%d)=%v) = %v", dist, k, cdf, got)
+			}
+			// Just above CDF(k) should be k+1.
+			if k < dist.N && cdf < 1 {
+				if got := dist.InvCDF(math.Nextafter(cdf, 1)); got != float64(k+1) {
+					t.Errorf("%+v.InvCDF(CDF(%d)+ε) = %v, want %d", dist, k, got, k+1)
+				}
+			}
+		}
+	}
+}
+
+func TestBinomialEntropy(t *testing.T) {
+	for _, c := range []struct {
+		d    BinomialDist
+		want float64
+	}{
+		{BinomialDist{1, 0.5}, math.Ln2},
+		{BinomialDist{2, 0.5}, 1.5 * math.Ln2},
+		{BinomialDist{10, 0}, 0},
+		{BinomialDist{10, 1}, 0},
+		// -Σ PMF(k) log PMF(k) for N=5, P=0.2.
+		{BinomialDist{5, 0.2}, 1.243024162311878},
+	} {
+		if got := c.d.Entropy(); !aeq(c.want, got) {
+			t.Errorf("%+v.Entropy() = %v, want %v", c.d, got, c.want)
+		}
+	}
+
+	// For large N, the entropy approaches that of the normal
+	// approximation.
+	d := BinomialDist{10000, 0.3}
+	got, want := d.Entropy(), d.NormalApprox().Entropy()
+	if math.Abs(got-want) > 1e-4 {
+		t.Errorf("%+v.Entropy() = %v, want ≈ %v", d, got, want)
+	}
+}
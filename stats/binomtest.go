@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	ErrInvalidCount       = errors.New("count is out of range")
+	ErrInvalidProbability = errors.New("probability is out of range")
+)
+
+// BinomialTest performs an exact binomial test of the null
+// hypothesis that successes out of n independent trials were
+// observed with success probability p, and returns its p-value.
+//
+// If alt is LocationGreater, the alternative hypothesis is that the
+// true success probability is greater than p, and the p-value is
+// Pr[X >= successes]. LocationLess is the reverse. If alt is
+// LocationDiffers, the p-value is the total probability of all
+// outcomes no more likely than successes.
+//
+// This can fail with ErrSampleSize if n <= 0, ErrInvalidCount if
+// successes is not in [0, n], or ErrInvalidProbability if p is not
+// in [0, 1].
+func BinomialTest(successes, n int, p float64, alt LocationHypothesis) (float64, error) {
+	if n <= 0 {
+		return 0, ErrSampleSize
+	}
+	if successes < 0 || successes > n {
+		return 0, ErrInvalidCount
+	}
+	if !(p >= 0 && p <= 1) {
+		return 0, ErrInvalidProbability
+	}
+	dist := BinomialDist{N: n, P: p}
+	k := float64(successes)
+
+	var pval float64
+	switch alt {
+	case LocationLess:
+		pval = dist.CDF(k)
+	case LocationGreater:
+		pval = 1 - dist.CDF(k-1)
+	case LocationDiffers:
+		// Allow for round-off when comparing probabilities,
+		// as R's binom.test does.
+		pk := dist.PMF(k) * (1 + 1e-7)
+		for i := 0; i <= n; i++ {
+			if pi := dist.PMF(float64(i)); pi <= pk {
+				pval += pi
+			}
+		}
+	}
+	return math.Min(pval, 1), nil
+}
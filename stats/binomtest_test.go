@@ -0,0 +1,52 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "testing"
+
+func TestBinomialTest(t *testing.T) {
+	for _, c := range []struct {
+		k, n int
+		p    float64
+		alt  LocationHypothesis
+		want float64
+	}{
+		// R: binom.test(8, 10) gives p-value = 0.1094.
+		{8, 10, 0.5, LocationDiffers, 2 * (45 + 10 + 1) / 1024.0},
+		{8, 10, 0.5, LocationGreater, (45 + 10 + 1) / 1024.0},
+		{8, 10, 0.5, LocationLess, 1 - (10+1)/1024.0},
+		{5, 10, 0.5, LocationDiffers, 1},
+		// Asymmetric null. Under Binomial(5, 0.2), only
+		// outcomes 3 through 5 are no more likely than 3.
+		{3, 5, 0.2, LocationDiffers, 0.0512 + 0.0064 + 0.00032},
+		// 1 is the mode, so every outcome qualifies.
+		{1, 5, 0.2, LocationDiffers, 1},
+		{0, 5, 0, LocationDiffers, 1},
+	} {
+		got, err := BinomialTest(c.k, c.n, c.p, c.alt)
+		if err != nil {
+			t.Errorf("BinomialTest(%d, %d, %v, %v): %v", c.k, c.n, c.p, c.alt, err)
+			continue
+		}
+		if !aeq(got, c.want) {
+			t.Errorf("BinomialTest(%d, %d, %v, %v) = %v, want %v", c.k, c.n, c.p, c.alt, got, c.want)
+		}
+	}
+
+	for _, c := range []struct {
+		k, n int
+		p    float64
+		err  error
+	}{
+		{0, 0, 0.5, ErrSampleSize},
+		{11, 10, 0.5, ErrInvalidCount},
+		{-1, 10, 0.5, ErrInvalidCount},
+		{1, 10, 1.5, ErrInvalidProbability},
+	} {
+		if _, err := BinomialTest(c.k, c.n, c.p, LocationDiffers); err != c.err {
+			t.Errorf("BinomialTest(%d, %d, %v) returned error %v, want %v", c.k, c.n, c.p, err, c.err)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math/rand"
+
+// Bootstrap returns n bootstrap replicates of statistic on xs. Each
+// replicate is statistic applied to a resample of xs drawn with
+// replacement, with the same size as xs. The distribution of the
+// replicates approximates the sampling distribution of statistic.
+//
+// statistic may modify its argument, which is a fresh resample on
+// each call.
+//
+// If r is nil, Bootstrap uses the default Source in math/rand.
+func Bootstrap(xs []float64, statistic func([]float64) float64, n int, r *rand.Rand) []float64 {
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+	out := make([]float64, n)
+	if len(xs) == 0 {
+		for i := range out {
+			out[i] = statistic(nil)
+		}
+		return out
+	}
+	resample := make([]float64, len(xs))
+	for i := range out {
+		for j := range resample {
+			resample[j] = xs[intn(len(xs))]
+		}
+		out[i] = statistic(resample)
+	}
+	return out
+}
+
+// BootstrapCI returns a 1-alpha confidence interval for statistic on
+// xs, computed by the percentile method from n bootstrap replicates.
+// That is, lo and hi are the alpha/2 and 1-alpha/2 quantiles of the
+// replicates returned by Bootstrap.
+//
+// The percentile method makes no distributional assumptions, but
+// tends to be too narrow for small samples. n should be at least
+// 1000 for a 95% interval.
+//
+// If r is nil, BootstrapCI uses the default Source in math/rand.
+func BootstrapCI(xs []float64, statistic func([]float64) float64, n int, alpha float64, r *rand.Rand) (lo, hi float64) {
+	reps := Sample{Xs: Bootstrap(xs, statistic, n, r)}
+	reps.Sort()
+	return reps.Quantile(alpha / 2), reps.Quantile(1 - alpha/2)
+}
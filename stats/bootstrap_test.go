@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestBootstrap(t *testing.T) {
+	xs := []float64{1, 2, 3, 4, 5}
+	reps := Bootstrap(xs, Mean, 1000, rand.New(rand.NewSource(1)))
+	if len(reps) != 1000 {
+		t.Fatalf("Bootstrap returned %d replicates, want 1000", len(reps))
+	}
+	for _, m := range reps {
+		if m < 1 || m > 5 {
+			t.Fatalf("bootstrap mean %v outside of sample range", m)
+		}
+	}
+	// The replicates have mean ≈ Mean(xs) and variance ≈
+	// (population variance)/n = 2/5.
+	if m := Mean(reps); math.Abs(m-3) > 0.1 {
+		t.Errorf("mean of bootstrap means = %v, want ~3", m)
+	}
+	if v := Variance(reps); math.Abs(v-0.4) > 0.05 {
+		t.Errorf("variance of bootstrap means = %v, want ~0.4", v)
+	}
+
+	// The same seed produces the same replicates.
+	again := Bootstrap(xs, Mean, 1000, rand.New(rand.NewSource(1)))
+	for i := range reps {
+		if reps[i] != again[i] {
+			t.Fatalf("Bootstrap is not reproducible with the same seed")
+		}
+	}
+}
+
+func TestBootstrapCI(t *testing.T) {
+	// The 95% CI of the mean should contain the true mean about
+	// 95% of the time. The percentile method is a little narrow
+	// for small samples, so allow some slack.
+	const trials = 200
+	r := rand.New(rand.NewSource(1))
+	dist := NormalDist{Mu: 10, Sigma: 3}
+	hits := 0
+	for i := 0; i < trials; i++ {
+		xs := SampleN(dist, 30, r)
+		lo, hi := BootstrapCI(xs, Mean, 1000, 0.05, r)
+		if !(lo < hi) {
+			t.Fatalf("BootstrapCI returned empty interval [%v, %v]", lo, hi)
+		}
+		if lo <= dist.Mu && dist.Mu <= hi {
+			hits++
+		}
+	}
+	if coverage := float64(hits) / trials; coverage < 0.88 || coverage > 0.99 {
+		t.Errorf("BootstrapCI coverage = %v, want ~0.95", coverage)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// CategoricalDist is a categorical distribution over the outcomes
+// {0, 1, ..., n-1}, where each outcome has a fixed probability.
+//
+// CategoricalDist should be constructed with NewCategoricalDist.
+type CategoricalDist struct {
+	// p[i] is the probability of outcome i.
+	p []float64
+
+	// cdf[i] is the sum of p[0] through p[i].
+	cdf []float64
+
+	// prob and alias are the tables for Walker's alias method.
+	prob  []float64
+	alias []int
+}
+
+var ErrInvalidWeights = errors.New("weights must be non-negative and not all zero")
+
+// NewCategoricalDist returns a categorical distribution in which the
+// probability of outcome i is proportional to weights[i].
+//
+// It returns ErrInvalidWeights if any weight is negative, infinite,
+// or NaN, or if all weights are zero.
+func NewCategoricalDist(weights []float64) (*CategoricalDist, error) {
+	max := 0.0
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			return nil, ErrInvalidWeights
+		}
+		max = math.Max(max, w)
+	}
+	if max == 0 {
+		return nil, ErrInvalidWeights
+	}
+	// Scale by the largest weight so the total cannot overflow
+	// even if the weights are individually finite.
+	total := 0.0
+	for _, w := range weights {
+		total += w / max
+	}
+
+	n := len(weights)
+	d := &CategoricalDist{
+		p:     make([]float64, n),
+		cdf:   make([]float64, n),
+		prob:  make([]float64, n),
+		alias: make([]int, n),
+	}
+	sum := 0.0
+	for i, w := range weights {
+		d.p[i] = w / max / total
+		sum += d.p[i]
+		d.cdf[i] = sum
+	}
+	d.cdf[n-1] = 1
+
+	// Construct the alias tables using Vose's algorithm. See
+	// Vose, Michael D. (1991). "A linear algorithm for generating
+	// random numbers with a given distribution". IEEE
+	// Transactions on Software Engineering 17 (9): 972–975.
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, p := range d.p {
+		scaled[i] = p * float64(n)
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		d.prob[s] = scaled[s]
+		d.alias[s] = l
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// Anything left over has probability 1 up to round-off.
+	for _, i := range large {
+		d.prob[i] = 1
+	}
+	for _, i := range small {
+		d.prob[i] = 1
+	}
+	return d, nil
+}
+
+// PMF is the probability of outcome int(k).
+func (d *CategoricalDist) PMF(k float64) float64 {
+	k = math.Floor(k)
+	if k < 0 || k >= float64(len(d.p)) {
+		return 0
+	}
+	return d.p[int(k)]
+}
+
+func (d *CategoricalDist) CDF(k float64) float64 {
+	k = math.Floor(k)
+	if k < 0 {
+		return 0
+	} else if k >= float64(len(d.p)) {
+		return 1
+	}
+	return d.cdf[int(k)]
+}
+
+func (d *CategoricalDist) Bounds() (float64, float64) {
+	return 0, float64(len(d.p) - 1)
+}
+
+func (d *CategoricalDist) Step() float64 {
+	return 1
+}
+
+// Mode returns the most probable outcome of d. If several outcomes
+// are equally probable, it returns the smallest.
+func (d *CategoricalDist) Mode() float64 {
+	best := 0
+	for i, p := range d.p {
+		if p > d.p[best] {
+			best = i
+		}
+	}
+	return float64(best)
+}
+
+// Rand returns a random outcome drawn from d. This takes constant
+// time regardless of the number of outcomes.
+func (d *CategoricalDist) Rand(r *rand.Rand) float64 {
+	var i int
+	var u float64
+	if r == nil {
+		i, u = rand.Intn(len(d.p)), rand.Float64()
+	} else {
+		i, u = r.Intn(len(d.p)), r.Float64()
+	}
+	if u < d.prob[i] {
+		return float64(i)
+	}
+	return float64(d.alias[i])
+}
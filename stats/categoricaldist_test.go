@@ -0,0 +1,115 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestCategoricalDist(t *testing.T) {
+	weights := []float64{1, 0, 3, 4, 2}
+	dist, err := NewCategoricalDist(weights)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testFunc(t, "CategoricalDist.PMF", dist.PMF,
+		map[float64]float64{
+			-1:  0,
+			0:   0.1,
+			1:   0,
+			2:   0.3,
+			2.5: 0.3,
+			3:   0.4,
+			4:   0.2,
+			5:   0,
+		})
+	testDiscreteCDF(t, "CategoricalDist.CDF", dist)
+
+	// Check the empirical distribution of Rand.
+	r := rand.New(rand.NewSource(1))
+	const n = 100000
+	counts := make([]int, len(weights))
+	for i := 0; i < n; i++ {
+		counts[int(dist.Rand(r))]++
+	}
+	for i, c := range counts {
+		want := dist.PMF(float64(i))
+		if got := float64(c) / n; math.Abs(got-want) > 0.01 {
+			t.Errorf("outcome %d drawn with frequency %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestCategoricalDistInvalid(t *testing.T) {
+	for _, weights := range [][]float64{
+		nil,
+		{0, 0},
+		{1, -1},
+		{1, nan},
+		{1, inf},
+	} {
+		if _, err := NewCategoricalDist(weights); err != ErrInvalidWeights {
+			t.Errorf("NewCategoricalDist(%v) returned error %v, want ErrInvalidWeights", weights, err)
+		}
+	}
+}
+
+func TestCategoricalDistHugeWeights(t *testing.T) {
+	// The weights are finite, but their sum overflows.
+	dist, err := NewCategoricalDist([]float64{1e308, 1e308, 1e308})
+	if err != nil {
+		t.Fatal(err)
+	}
+	third := 1.0 / 3
+	testFunc(t, "CategoricalDist.PMF", dist.PMF,
+		map[float64]float64{0: third, 1: third, 2: third})
+	testDiscreteCDF(t, "CategoricalDist.CDF", dist)
+}
+
+func benchmarkCategoricalWeights() []float64 {
+	r := rand.New(rand.NewSource(1))
+	weights := make([]float64, 1000)
+	for i := range weights {
+		weights[i] = r.Float64()
+	}
+	return weights
+}
+
+func BenchmarkCategoricalDistRand(b *testing.B) {
+	dist, _ := NewCategoricalDist(benchmarkCategoricalWeights())
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dist.Rand(r)
+	}
+}
+
+func BenchmarkCategoricalDistLinear(b *testing.B) {
+	// Naive sampling by linear search of the CDF, for comparison
+	// with the alias method.
+	dist, _ := NewCategoricalDist(benchmarkCategoricalWeights())
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u := r.Float64()
+		for k := range dist.cdf {
+			if u < dist.cdf[k] {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkCategoricalDistBinarySearch(b *testing.B) {
+	dist, _ := NewCategoricalDist(benchmarkCategoricalWeights())
+	r := rand.New(rand.NewSource(1))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sort.SearchFloat64s(dist.cdf, r.Float64())
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// CauchyDist is a Cauchy (or Lorentz) distribution with location X0
+// and scale Gamma.
+//
+// The Cauchy distribution has heavy tails that decay like 1/x², so
+// its mean and variance are undefined.
+type CauchyDist struct {
+	// X0 is the location of the peak of the distribution. This
+	// is also the median and the mode.
+	X0 float64
+
+	// Gamma is the scale parameter, which is half the width of
+	// the PDF at half its maximum. Gamma > 0.
+	Gamma float64
+}
+
+func (d CauchyDist) PDF(x float64) float64 {
+	z := (x - d.X0) / d.Gamma
+	return 1 / (math.Pi * d.Gamma * (1 + z*z))
+}
+
+func (d CauchyDist) CDF(x float64) float64 {
+	return 0.5 + math.Atan((x-d.X0)/d.Gamma)/math.Pi
+}
+
+func (d CauchyDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 {
+		return nan
+	} else if p == 0 {
+		return -inf
+	} else if p == 1 {
+		return inf
+	}
+	return d.X0 + d.Gamma*math.Tan(math.Pi*(p-0.5))
+}
+
+// Rand returns a random sample drawn from d using inverse transform
+// sampling.
+func (d CauchyDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	for u == 0 {
+		if r == nil {
+			u = rand.Float64()
+		} else {
+			u = r.Float64()
+		}
+	}
+	return d.InvCDF(u)
+}
+
+// Bounds returns the bounds of the central 99% of d. Because of its
+// heavy tails, a non-trivial fraction of the Cauchy distribution's
+// weight lies outside any reasonable bounds.
+func (d CauchyDist) Bounds() (float64, float64) {
+	return d.InvCDF(0.005), d.InvCDF(0.995)
+}
+
+// Mean returns NaN, since the mean of a Cauchy distribution is
+// undefined.
+func (d CauchyDist) Mean() float64 {
+	return nan
+}
+
+// Variance returns NaN, since the variance of a Cauchy distribution
+// is undefined.
+func (d CauchyDist) Variance() float64 {
+	return nan
+}
+
+func (d CauchyDist) Median() float64 {
+	return d.X0
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestCauchyDist(t *testing.T) {
+	d := CauchyDist{X0: 1, Gamma: 2}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-1: 1 / (4 * math.Pi),
+		1:  1 / (2 * math.Pi),
+		3:  1 / (4 * math.Pi),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-inf: 0,
+		-1:   0.25,
+		1:    0.5,
+		3:    0.75,
+		inf:  1,
+	})
+	if got := d.InvCDF(0.5); got != d.X0 {
+		t.Errorf("%+v.InvCDF(0.5) = %v, want %v", d, got, d.X0)
+	}
+	testInvCDF(t, d, false)
+	testPDFIntegral(t, d, vec.Linspace(-20, 20, 11))
+
+	if !math.IsNaN(d.Mean()) || !math.IsNaN(d.Variance()) {
+		t.Errorf("%+v mean and variance should be NaN; got %v, %v", d, d.Mean(), d.Variance())
+	}
+}
+
+func TestCauchyDistTails(t *testing.T) {
+	// PDF(x) ~ Gamma/(π x²) as x → ±∞.
+	d := CauchyDist{X0: 0, Gamma: 3}
+	for _, x := range []float64{1e4, 1e6, 1e8} {
+		for _, x := range []float64{-x, x} {
+			got := d.PDF(x) * x * x
+			if want := d.Gamma / math.Pi; math.Abs(got/want-1) > 1e-6 {
+				t.Errorf("%+v.PDF(%v)*x² = %v, want %v", d, x, got, want)
+			}
+		}
+	}
+}
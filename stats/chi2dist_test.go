@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestChiSquaredDist(t *testing.T) {
+	d := ChiSquaredDist{DF: 2}
+	// With DF=2, this is an exponential distribution with rate
+	// 1/2.
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-1: 0,
+		0:  0.5,
+		2:  0.5 * math.Exp(-1),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-1: 0,
+		0:  0,
+		2:  1 - math.Exp(-1),
+	})
+	testPDFIntegral(t, ChiSquaredDist{DF: 5}, vec.Linspace(0, 20, 11))
+
+	// ChiSquaredDist is a special case of GammaDist.
+	for _, k := range []float64{1, 2, 3, 10, 50} {
+		chi2 := ChiSquaredDist{DF: k}
+		gamma := GammaDist{Shape: k / 2, Scale: 2}
+		for _, x := range vec.Linspace(0, 3*k, 31) {
+			if a, b := chi2.CDF(x), gamma.CDF(x); math.Abs(a-b) > 1e-9 {
+				t.Errorf("%+v.CDF(%v) = %v, but %+v.CDF(%v) = %v", chi2, x, a, gamma, x, b)
+			}
+		}
+	}
+}
+
+func TestChiSquaredDistInvCDF(t *testing.T) {
+	ps := []float64{1e-10, 0.001, 0.01, 0.05, 0.5, 0.95, 0.99, 0.999, 1 - 1e-10}
+	for _, k := range []float64{0.5, 1, 2, 5, 10, 100, 1000} {
+		d := ChiSquaredDist{DF: k}
+		testFunc(t, fmt.Sprintf("%+v.InvCDF", d), d.InvCDF, map[float64]float64{
+			-0.1: nan,
+			0:    0,
+			1:    inf,
+			1.1:  nan,
+		})
+		for _, p := range ps {
+			if got := d.CDF(d.InvCDF(p)); !aeq(p, got) {
+				t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+			}
+		}
+	}
+
+	// Tabulated upper critical values.
+	for _, c := range []struct{ k, p, x float64 }{
+		{1, 0.95, 3.841458820694124},
+		{1, 0.99, 6.6348966010212145},
+		{10, 0.95, 18.307038053275146},
+		{10, 0.99, 23.209251158954356},
+	} {
+		d := ChiSquaredDist{c.k}
+		if got := d.InvCDF(c.p); !aeq(c.x, got) {
+			t.Errorf("%+v.InvCDF(%v) = %v, want %v", d, c.p, got, c.x)
+		}
+	}
+}
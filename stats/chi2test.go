@@ -0,0 +1,119 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"errors"
+	"math"
+)
+
+// A ChiSquaredTestResult is the result of a chi-squared test.
+type ChiSquaredTestResult struct {
+	// X2 is Pearson's chi-squared statistic, Σ (O-E)²/E.
+	X2 float64
+
+	// DoF is the degrees of freedom of the chi-squared
+	// distribution used to compute P.
+	DoF int
+
+	// P is the p-value of the test.
+	P float64
+}
+
+var (
+	ErrZeroExpected = errors.New("expected count is zero")
+	ErrInvalidTable = errors.New("contingency table is ragged or has negative cells")
+)
+
+func newChiSquaredTestResult(x2 float64, dof int) *ChiSquaredTestResult {
+	p := 1 - ChiSquaredDist{float64(dof)}.CDF(x2)
+	return &ChiSquaredTestResult{X2: x2, DoF: dof, P: p}
+}
+
+// ChiSquaredGoodnessOfFit performs Pearson's chi-squared
+// goodness-of-fit test of the null hypothesis that the counts in
+// observed are drawn from the distribution whose expected counts are
+// given by expected. The statistic has len(observed)-1-ddof degrees of
+// freedom, where ddof is the number of parameters of the expected
+// distribution that were estimated from observed.
+//
+// This can fail with ErrMismatchedSamples if observed and expected
+// have different lengths, ErrZeroExpected if any expected count is
+// zero, or ErrSampleSize if there are no degrees of freedom.
+func ChiSquaredGoodnessOfFit(observed, expected []float64, ddof int) (*ChiSquaredTestResult, error) {
+	if len(observed) != len(expected) {
+		return nil, ErrMismatchedSamples
+	}
+	dof := len(observed) - 1 - ddof
+	if dof <= 0 {
+		return nil, ErrSampleSize
+	}
+	x2 := 0.0
+	for i, o := range observed {
+		e := expected[i]
+		if e == 0 {
+			return nil, ErrZeroExpected
+		}
+		x2 += (o - e) * (o - e) / e
+	}
+	return newChiSquaredTestResult(x2, dof), nil
+}
+
+// ChiSquaredIndependence performs Pearson's chi-squared test of
+// independence on a contingency table. table[i][j] is the observed
+// count for row category i and column category j. The null
+// hypothesis is that the row and column categories are independent,
+// in which case the expected count of each cell is the product of its
+// row and column totals divided by the grand total. The statistic has
+// (rows-1)*(cols-1) degrees of freedom.
+//
+// If yates is true and table is 2x2, this applies Yates' continuity
+// correction, reducing each |O-E| by 0.5 (but not below 0).
+//
+// This can fail with ErrInvalidTable if table is ragged or has a
+// negative cell, ErrSampleSize if table has fewer than two rows or
+// columns, or ErrZeroExpected if any row or column total is zero.
+func ChiSquaredIndependence(table [][]float64, yates bool) (*ChiSquaredTestResult, error) {
+	rows := len(table)
+	if rows == 0 {
+		return nil, ErrSampleSize
+	}
+	cols := len(table[0])
+	rowSum, colSum := make([]float64, rows), make([]float64, cols)
+	total := 0.0
+	for i, row := range table {
+		if len(row) != cols {
+			return nil, ErrInvalidTable
+		}
+		for j, o := range row {
+			if !(o >= 0) {
+				return nil, ErrInvalidTable
+			}
+			rowSum[i] += o
+			colSum[j] += o
+			total += o
+		}
+	}
+	if rows < 2 || cols < 2 {
+		return nil, ErrSampleSize
+	}
+
+	yates = yates && rows == 2 && cols == 2
+	x2 := 0.0
+	for i, row := range table {
+		for j, o := range row {
+			e := rowSum[i] * colSum[j] / total
+			if !(e > 0) {
+				return nil, ErrZeroExpected
+			}
+			d := math.Abs(o - e)
+			if yates {
+				d = math.Max(0, d-0.5)
+			}
+			x2 += d * d / e
+		}
+	}
+	return newChiSquaredTestResult(x2, (rows-1)*(cols-1)), nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "testing"
+
+func TestChiSquaredGoodnessOfFit(t *testing.T) {
+	obs := []float64{10, 20, 30, 40}
+	res, err := ChiSquaredGoodnessOfFit(obs, obs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.X2 != 0 || res.DoF != 3 || res.P != 1 {
+		t.Errorf("ChiSquaredGoodnessOfFit(O, O) = %+v, want X2=0, DoF=3, P=1", res)
+	}
+
+	// A fair die rolled 60 times. X² = (25+4+1+1+4+25)/10 = 6
+	// with 5 degrees of freedom.
+	res, err = ChiSquaredGoodnessOfFit(
+		[]float64{5, 8, 9, 11, 12, 15},
+		[]float64{10, 10, 10, 10, 10, 10}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aeq(res.X2, 6) || res.DoF != 5 || !aeq(res.P, 0.30621891841327) {
+		t.Errorf("ChiSquaredGoodnessOfFit(die) = %+v", res)
+	}
+
+	// A clearly deviating case.
+	res, err = ChiSquaredGoodnessOfFit(
+		[]float64{50, 2, 3, 5},
+		[]float64{15, 15, 15, 15}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.DoF != 2 || res.P > 1e-10 {
+		t.Errorf("ChiSquaredGoodnessOfFit(deviating) = %+v, want tiny P", res)
+	}
+
+	if _, err := ChiSquaredGoodnessOfFit(obs, obs[:3], 0); err != ErrMismatchedSamples {
+		t.Errorf("mismatched lengths returned error %v, want ErrMismatchedSamples", err)
+	}
+	if _, err := ChiSquaredGoodnessOfFit(obs, []float64{10, 0, 30, 40}, 0); err != ErrZeroExpected {
+		t.Errorf("zero expected count returned error %v, want ErrZeroExpected", err)
+	}
+	if _, err := ChiSquaredGoodnessOfFit(obs, obs, 3); err != ErrSampleSize {
+		t.Errorf("no degrees of freedom returned error %v, want ErrSampleSize", err)
+	}
+}
+
+func TestChiSquaredIndependence(t *testing.T) {
+	for _, c := range []struct {
+		table [][]float64
+		yates bool
+		x2    float64
+		dof   int
+		p     float64
+	}{
+		{[][]float64{{12, 5}, {7, 9}}, false, 2.4305755196815575, 1, 0.11898920553214518},
+		{[][]float64{{12, 5}, {7, 9}}, true, 1.455996378814684, 1, 0.22756821457580978},
+		{[][]float64{{20, 15, 25}, {30, 35, 10}, {10, 20, 15}}, false, 17.466666666666665, 4, 0.0015682782573911563},
+		// Yates' correction only applies to 2x2 tables.
+		{[][]float64{{20, 15, 25}, {30, 35, 10}, {10, 20, 15}}, true, 17.466666666666665, 4, 0.0015682782573911563},
+		// Independent rows and columns.
+		{[][]float64{{10, 20}, {30, 60}}, false, 0, 1, 1},
+	} {
+		res, err := ChiSquaredIndependence(c.table, c.yates)
+		if err != nil {
+			t.Errorf("ChiSquaredIndependence(%v, %v): %v", c.table, c.yates, err)
+			continue
+		}
+		if !aeq(res.X2, c.x2) || res.DoF != c.dof || !aeq(res.P, c.p) {
+			t.Errorf("ChiSquaredIndependence(%v, %v) = %+v, want X2=%v, DoF=%v, P=%v", c.table, c.yates, res, c.x2, c.dof, c.p)
+		}
+	}
+
+	for _, c := range []struct {
+		table [][]float64
+		err   error
+	}{
+		{[][]float64{{1, 2}, {3}}, ErrInvalidTable},
+		{[][]float64{{1, 2}, {3, -1}}, ErrInvalidTable},
+		{[][]float64{{1, 2}}, ErrSampleSize},
+		{nil, ErrSampleSize},
+		{[][]float64{{1, 0}, {3, 0}}, ErrZeroExpected},
+	} {
+		if _, err := ChiSquaredIndependence(c.table, false); err != c.err {
+			t.Errorf("ChiSquaredIndependence(%v) returned error %v, want %v", c.table, err, c.err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "sort"
+
+// CliffsDelta returns Cliff's delta, a nonparametric effect size for
+// the difference between samples a and b. This is the probability
+// that a value drawn from a is greater than a value drawn from b,
+// minus the probability that it is less:
+//
+//	δ = (#(a[i] > b[j]) - #(a[i] < b[j])) / (len(a) * len(b))
+//
+// Ties count as neither greater nor less. δ ranges from -1, when
+// every value in a is less than every value in b, to 1, when every
+// value in a is greater. It is closely related to the Mann-Whitney U
+// statistic of a and b by δ = 2U/(len(a) len(b)) - 1, and can be
+// reported alongside the p-value of MannWhitneyUTest.
+//
+// If either sample is empty, CliffsDelta returns NaN.
+func CliffsDelta(a, b []float64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return nan
+	}
+
+	sb := append([]float64(nil), b...)
+	sort.Float64s(sb)
+	// Count dominance with a binary search of b for each value
+	// of a. This takes O((n+m) log m) time rather than O(nm).
+	var dom int
+	for _, x := range a {
+		less := sort.SearchFloat64s(sb, x)
+		greater := len(sb) - sort.Search(len(sb), func(i int) bool { return sb[i] > x })
+		dom += less - greater
+	}
+	return float64(dom) / (float64(len(a)) * float64(len(b)))
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCliffsDelta(t *testing.T) {
+	for _, test := range []struct {
+		a, b []float64
+		want float64
+	}{
+		// Fully separated groups.
+		{[]float64{4, 5, 6}, []float64{1, 2, 3}, 1},
+		{[]float64{1, 2, 3}, []float64{4, 5, 6}, -1},
+		// Identical groups.
+		{[]float64{1, 2, 3}, []float64{1, 2, 3}, 0},
+		{[]float64{2, 2}, []float64{2, 2, 2}, 0},
+		// Overlapping groups with ties: of the 9 pairs, 5 have
+		// a > b, 1 is tied, and 3 have a < b.
+		{[]float64{1, 3, 5}, []float64{0, 3, 4}, 2.0 / 9},
+	} {
+		if got := CliffsDelta(test.a, test.b); !aeq(got, test.want) {
+			t.Errorf("CliffsDelta(%v, %v) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+
+	if got := CliffsDelta(nil, []float64{1}); !math.IsNaN(got) {
+		t.Errorf("CliffsDelta(nil, [1]) = %v, want NaN", got)
+	}
+}
+
+func TestCliffsDeltaMannWhitney(t *testing.T) {
+	a := []float64{1.83, 0.50, 1.62, 2.48, 1.68, 1.88, 1.55, 3.06, 1.30}
+	b := []float64{0.878, 0.647, 0.598, 2.05, 1.06, 1.29, 1.06, 3.14, 1.29}
+	res, err := MannWhitneyUTest(a, b, LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 2*res.U/float64(len(a)*len(b)) - 1
+	if got := CliffsDelta(a, b); !aeq(got, want) {
+		t.Errorf("CliffsDelta(%v, %v) = %v, want %v from U", a, b, got, want)
+	}
+}
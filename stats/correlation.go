@@ -0,0 +1,194 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// sumsOfProducts returns the sums of the products of the deviations
+// of x and y from their means: Σ(x-x̄)(y-ȳ), Σ(x-x̄)², and Σ(y-ȳ)².
+// x and y must have the same length.
+func sumsOfProducts(x, y []float64) (sxy, sxx, syy float64) {
+	mx, my := Mean(x), Mean(y)
+	for i := range x {
+		dx, dy := x[i]-mx, y[i]-my
+		sxy += dx * dy
+		sxx += dx * dx
+		syy += dy * dy
+	}
+	return
+}
+
+// Covariance returns the sample covariance of x and y,
+// Σ(x-x̄)(y-ȳ)/(n-1). It panics if x and y have different lengths.
+//
+// If x and y have fewer than 2 values, Covariance returns NaN.
+func Covariance(x, y []float64) float64 {
+	if len(x) != len(y) {
+		panic("x and y must have the same length")
+	}
+	if len(x) < 2 {
+		return math.NaN()
+	}
+	sxy, _, _ := sumsOfProducts(x, y)
+	return sxy / float64(len(x)-1)
+}
+
+// Correlation returns the Pearson product-moment correlation
+// coefficient r of x and y, which is their covariance divided by the
+// product of their standard deviations. It panics if x and y have
+// different lengths. See also PearsonCorrelation, which also tests
+// the significance of r.
+//
+// If x and y have fewer than 2 values, or either has zero variance,
+// Correlation returns NaN.
+func Correlation(x, y []float64) float64 {
+	if len(x) != len(y) {
+		panic("x and y must have the same length")
+	}
+	if len(x) < 2 {
+		return math.NaN()
+	}
+	return correlation(sumsOfProducts(x, y))
+}
+
+// correlation returns the correlation coefficient given the sums of
+// products computed by sumsOfProducts, or NaN if either variance is
+// zero.
+func correlation(sxy, sxx, syy float64) float64 {
+	if sxx == 0 || syy == 0 {
+		return math.NaN()
+	}
+	r := sxy / math.Sqrt(sxx*syy)
+	// Round-off can push r slightly outside [-1, 1].
+	return math.Max(-1, math.Min(1, r))
+}
+
+// PearsonCorrelation returns the Pearson product-moment correlation
+// coefficient r of x and y, along with the two-sided p-value of the
+// null hypothesis that the true correlation is 0. The p-value is
+// computed from the statistic t = r√((n-2)/(1-r²)), which has a
+// Student's t-distribution with n-2 degrees of freedom if x and y are
+// independent and normally distributed.
+//
+// This can fail with ErrMismatchedSamples if x and y have different
+// lengths, ErrSampleSize if they have fewer than 3 values, or
+// ErrZeroVariance if either has zero variance.
+func PearsonCorrelation(x, y []float64) (r, p float64, err error) {
+	if len(x) != len(y) {
+		return 0, 0, ErrMismatchedSamples
+	}
+	n := len(x)
+	if n < 3 {
+		return 0, 0, ErrSampleSize
+	}
+	sxy, sxx, syy := sumsOfProducts(x, y)
+	if sxx == 0 || syy == 0 {
+		return 0, 0, ErrZeroVariance
+	}
+	r = correlation(sxy, sxx, syy)
+
+	dof := float64(n - 2)
+	if r == 1 || r == -1 {
+		return r, 0, nil
+	}
+	t := r * math.Sqrt(dof/(1-r*r))
+	p = 2 * TDist{dof}.CDF(-math.Abs(t))
+	return r, p, nil
+}
+
+// SpearmanCorrelation returns Spearman's rank correlation coefficient
+// ρ of x and y, along with the two-sided p-value of the null
+// hypothesis that the true rank correlation is 0. ρ is Pearson's r
+// computed on the ranks of x and y, where tied values are assigned
+// their average rank, and the p-value uses the same t approximation
+// as PearsonCorrelation.
+//
+// Unlike Pearson's r, ρ measures how well the relationship between x
+// and y can be described by any monotonic function, and is robust to
+// outliers.
+//
+// This can fail with the same errors as PearsonCorrelation.
+func SpearmanCorrelation(x, y []float64) (rho, p float64, err error) {
+	if len(x) != len(y) {
+		return 0, 0, ErrMismatchedSamples
+	}
+	rx := Ranks(x, TieAverage)
+	ry := Ranks(y, TieAverage)
+	return PearsonCorrelation(rx, ry)
+}
+
+// KendallTau returns Kendall's rank correlation coefficient τ-b of x
+// and y, along with the two-sided p-value of the null hypothesis that
+// the true rank correlation is 0.
+//
+// τ-b is (C - D) / √((n0 - n1)(n0 - n2)), where C and D are the
+// numbers of concordant and discordant pairs, n0 = n(n-1)/2, and n1
+// and n2 are the numbers of pairs tied in x and in y, respectively.
+// The p-value uses a normal approximation to the distribution of
+// C - D with the variance corrected for ties.
+//
+// This can fail with ErrMismatchedSamples if x and y have different
+// lengths, ErrSampleSize if they have fewer than 3 values, or
+// ErrZeroVariance if all values of x or of y are tied.
+func KendallTau(x, y []float64) (tau, p float64, err error) {
+	if len(x) != len(y) {
+		return 0, 0, ErrMismatchedSamples
+	}
+	n := len(x)
+	if n < 3 {
+		return 0, 0, ErrSampleSize
+	}
+
+	// Count concordant and discordant pairs.
+	//
+	// TODO: This is O(n²). Knight's (1966) algorithm computes
+	// this in O(n log n) by sorting on x and counting the swaps a
+	// merge sort makes when sorting on y.
+	s := 0
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			sign := (x[i] - x[j]) * (y[i] - y[j])
+			if sign > 0 {
+				s++
+			} else if sign < 0 {
+				s--
+			}
+		}
+	}
+
+	_, tx := ranksAndTies(x, TieAverage)
+	_, ty := ranksAndTies(y, TieAverage)
+	fn := float64(n)
+	n0 := fn * (fn - 1) / 2
+	var n1, n2 float64
+	var vt, vu, t1, u1, t2, u2 float64
+	for _, t := range tx {
+		t := float64(t)
+		n1 += t * (t - 1) / 2
+		vt += t * (t - 1) * (2*t + 5)
+		t1 += t * (t - 1)
+		t2 += t * (t - 1) * (t - 2)
+	}
+	for _, u := range ty {
+		u := float64(u)
+		n2 += u * (u - 1) / 2
+		vu += u * (u - 1) * (2*u + 5)
+		u1 += u * (u - 1)
+		u2 += u * (u - 1) * (u - 2)
+	}
+	if n1 == n0 || n2 == n0 {
+		return 0, 0, ErrZeroVariance
+	}
+	tau = float64(s) / math.Sqrt((n0-n1)*(n0-n2))
+
+	// Variance of S = C - D under the null hypothesis, with tie
+	// correction. See Kendall (1970), "Rank Correlation Methods".
+	v := (fn*(fn-1)*(2*fn+5)-vt-vu)/18 +
+		t1*u1/(2*fn*(fn-1)) +
+		t2*u2/(9*fn*(fn-1)*(fn-2))
+	z := float64(s) / math.Sqrt(v)
+	p = 2 * StdNormal.CDF(-math.Abs(z))
+	return tau, p, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCovariance(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	// y = 3x - 1, so cov(x, y) = 3 var(x) = 7.5.
+	y := []float64{2, 5, 8, 11, 14}
+	if got := Covariance(x, y); !aeq(got, 7.5) {
+		t.Errorf("Covariance(%v, %v) = %v, want 7.5", x, y, got)
+	}
+	if got := Covariance(x, x); !aeq(got, Variance(x)) {
+		t.Errorf("Covariance(%v, %v) = %v, want %v", x, x, got, Variance(x))
+	}
+	if got, want := Correlation(x, y), 1.0; got != want {
+		t.Errorf("Correlation(%v, %v) = %v, want %v", x, y, got, want)
+	}
+
+	// A decreasing relationship has negative covariance.
+	neg := []float64{10, 8, 6, 4, 2}
+	if got := Covariance(x, neg); !aeq(got, -5) {
+		t.Errorf("Covariance(%v, %v) = %v, want -5", x, neg, got)
+	}
+	if got := Correlation(x, neg); got != -1 {
+		t.Errorf("Correlation(%v, %v) = %v, want -1", x, neg, got)
+	}
+
+	// Correlation agrees with PearsonCorrelation.
+	noisy := []float64{1.2, 1.9, 3.4, 3.8, 5.3}
+	r, _, err := PearsonCorrelation(x, noisy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := Correlation(x, noisy); got != r {
+		t.Errorf("Correlation(%v, %v) = %v, but PearsonCorrelation gives %v", x, noisy, got, r)
+	}
+
+	if got := Covariance([]float64{1}, []float64{2}); !math.IsNaN(got) {
+		t.Errorf("Covariance of one point = %v, want NaN", got)
+	}
+	if got := Correlation(x, []float64{1, 1, 1, 1, 1}); !math.IsNaN(got) {
+		t.Errorf("Correlation with constant = %v, want NaN", got)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Covariance with mismatched lengths did not panic")
+			}
+		}()
+		Covariance(x, y[:3])
+	}()
+}
+
+func TestPearsonCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6}
+	check := func(y []float64, wantR, wantP float64) {
+		t.Helper()
+		r, p, err := PearsonCorrelation(x, y)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !aeq(r, wantR) || !aeq(p, wantP) {
+			t.Errorf("PearsonCorrelation(%v, %v) = %v, %v, want %v, %v", x, y, r, p, wantR, wantP)
+		}
+	}
+	check([]float64{3, 5, 7, 9, 11, 13}, 1, 0)
+	check([]float64{6, 4, 2, 0, -2, -4}, -1, 0)
+	// y is symmetric about the middle of x.
+	check([]float64{1, 3, 5, 5, 3, 1}, 0, 1)
+
+	// Sxy = 16, Sxx = 17.5, and Syy = 70/3. The p-value comes
+	// from t = r√((n-2)/(1-r²)) on 4 degrees of freedom.
+	y := []float64{2, 1, 4, 3, 7, 5}
+	r, p, err := PearsonCorrelation(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 16 / math.Sqrt(17.5*70/3); !aeq(r, want) {
+		t.Errorf("PearsonCorrelation(%v, %v) r = %v, want %v", x, y, r, want)
+	}
+	tt := r * math.Sqrt(4/(1-r*r))
+	if want := 2 * (1 - TDist{4}.CDF(tt)); !aeq(p, want) {
+		t.Errorf("PearsonCorrelation(%v, %v) p = %v, want %v", x, y, p, want)
+	}
+
+	if _, _, err := PearsonCorrelation(x, x[:3]); err != ErrMismatchedSamples {
+		t.Errorf("mismatched lengths returned error %v, want ErrMismatchedSamples", err)
+	}
+	if _, _, err := PearsonCorrelation(x[:2], x[:2]); err != ErrSampleSize {
+		t.Errorf("two values returned error %v, want ErrSampleSize", err)
+	}
+	if _, _, err := PearsonCorrelation(x, []float64{1, 1, 1, 1, 1, 1}); err != ErrZeroVariance {
+		t.Errorf("constant y returned error %v, want ErrZeroVariance", err)
+	}
+}
+
+func TestSpearmanCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6, 7}
+	check := func(y []float64, wantRho float64) {
+		t.Helper()
+		rho, _, err := SpearmanCorrelation(x, y)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !aeq(rho, wantRho) {
+			t.Errorf("SpearmanCorrelation(%v, %v) = %v, want %v", x, y, rho, wantRho)
+		}
+	}
+	// Strictly monotonic but nonlinear.
+	y := make([]float64, len(x))
+	for i, v := range x {
+		y[i] = math.Exp(v)
+	}
+	check(y, 1)
+	for i, v := range x {
+		y[i] = -v * v * v
+	}
+	check(y, -1)
+	// An outlier doesn't change the ranks.
+	check([]float64{1, 3, 2, 4, 5, 6, 1e9}, 1-6*2.0/(7*48))
+
+	// With ties, ρ is Pearson's r on the average ranks.
+	y = []float64{1, 2, 2, 3, 5, 5, 4}
+	rho, p, err := SpearmanCorrelation(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantRho, wantP, _ := PearsonCorrelation(x, []float64{1, 2.5, 2.5, 4, 6.5, 6.5, 5})
+	if !aeq(rho, wantRho) || !aeq(p, wantP) {
+		t.Errorf("SpearmanCorrelation with ties = %v, %v, want %v, %v", rho, p, wantRho, wantP)
+	}
+
+	if _, _, err := SpearmanCorrelation(x, x[:3]); err != ErrMismatchedSamples {
+		t.Errorf("mismatched lengths returned error %v, want ErrMismatchedSamples", err)
+	}
+}
+
+func TestKendallTau(t *testing.T) {
+	// Of the 15 pairs, 11 are concordant and 2 are discordant.
+	// The remaining two are tied in x only (2, 2) and in y only
+	// (2, 2), so n1 = n2 = 1 and τ-b = 9/√(14·14).
+	x := []float64{1, 2, 2, 3, 4, 5}
+	y := []float64{1, 3, 2, 2, 5, 4}
+	tau, p, err := KendallTau(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aeq(tau, 9.0/14) || !aeq(p, 0.07983871964585258) {
+		t.Errorf("KendallTau(%v, %v) = %v, %v, want %v, %v", x, y, tau, p, 9.0/14, 0.07983871964585258)
+	}
+
+	// Perfectly monotonic data.
+	x = []float64{1, 2, 3, 4, 5}
+	if tau, _, _ := KendallTau(x, []float64{1, 4, 9, 16, 25}); tau != 1 {
+		t.Errorf("KendallTau of increasing data = %v, want 1", tau)
+	}
+	if tau, _, _ := KendallTau(x, []float64{5, 4, 3, 2, 1}); tau != -1 {
+		t.Errorf("KendallTau of decreasing data = %v, want -1", tau)
+	}
+
+	if _, _, err := KendallTau(x, x[:3]); err != ErrMismatchedSamples {
+		t.Errorf("mismatched lengths returned error %v, want ErrMismatchedSamples", err)
+	}
+	if _, _, err := KendallTau(x[:2], x[:2]); err != ErrSampleSize {
+		t.Errorf("two values returned error %v, want ErrSampleSize", err)
+	}
+	if _, _, err := KendallTau(x, []float64{1, 1, 1, 1, 1}); err != ErrZeroVariance {
+		t.Errorf("constant y returned error %v, want ErrZeroVariance", err)
+	}
+}
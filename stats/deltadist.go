@@ -4,6 +4,8 @@
 
 package stats
 
+import "math/rand"
+
 // DeltaDist is the Dirac delta function, centered at T, with total
 // area 1.
 //
@@ -52,6 +54,10 @@ func (d DeltaDist) InvCDF(y float64) float64 {
 	return d.T
 }
 
+func (d DeltaDist) Rand(r *rand.Rand) float64 {
+	return d.T
+}
+
 func (d DeltaDist) Bounds() (float64, float64) {
 	return d.T - 1, d.T + 1
 }
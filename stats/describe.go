@@ -0,0 +1,84 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// A Description summarizes a sample. See Describe.
+type Description struct {
+	// Count is the number of values in the sample.
+	Count int
+
+	// Mean and StdDev are the sample mean and sample standard
+	// deviation.
+	Mean, StdDev float64
+
+	// Min, Q1, Median, Q3, and Max are the minimum, first
+	// quartile, median, third quartile, and maximum of the
+	// sample, as computed by Sample.Quantile.
+	Min, Q1, Median, Q3, Max float64
+
+	// Skewness and Kurtosis are the bias-corrected sample
+	// skewness and excess kurtosis. These are NaN if the sample
+	// is too small or has zero variance.
+	Skewness, Kurtosis float64
+}
+
+// Describe returns summary statistics of xs. This is similar to
+// pandas' describe. It does not modify xs.
+//
+// If xs is empty, all fields other than Count are NaN.
+func Describe(xs []float64) Description {
+	if len(xs) == 0 {
+		return Description{
+			Mean: math.NaN(), StdDev: math.NaN(),
+			Min: math.NaN(), Q1: math.NaN(), Median: math.NaN(), Q3: math.NaN(), Max: math.NaN(),
+			Skewness: math.NaN(), Kurtosis: math.NaN(),
+		}
+	}
+
+	// Sort a copy once so the order statistics are constant time.
+	s := Sample{Xs: xs}.Copy().Sort()
+	min, max := s.Bounds()
+	return Description{
+		Count:    len(xs),
+		Mean:     s.Mean(),
+		StdDev:   s.StdDev(),
+		Min:      min,
+		Q1:       s.Quantile(0.25),
+		Median:   s.Quantile(0.5),
+		Q3:       s.Quantile(0.75),
+		Max:      max,
+		Skewness: s.Skewness(),
+		Kurtosis: s.Kurtosis(),
+	}
+}
+
+// String formats d as a table with one statistic per line.
+func (d Description) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-8s %d\n", "count", d.Count)
+	for _, row := range []struct {
+		name string
+		val  float64
+	}{
+		{"mean", d.Mean},
+		{"stddev", d.StdDev},
+		{"min", d.Min},
+		{"25%", d.Q1},
+		{"median", d.Median},
+		{"75%", d.Q3},
+		{"max", d.Max},
+		{"skewness", d.Skewness},
+		{"kurtosis", d.Kurtosis},
+	} {
+		fmt.Fprintf(&b, "%-8s %.6g\n", row.name, row.val)
+	}
+	return b.String()
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDescribe(t *testing.T) {
+	xs := []float64{3, 4, 5, 2, 3, 4, 5, 6, 4, 7}
+	orig := append([]float64(nil), xs...)
+	d := Describe(xs)
+	for i := range xs {
+		if xs[i] != orig[i] {
+			t.Fatalf("Describe modified its input")
+		}
+	}
+
+	s := Sample{Xs: orig}
+	if d.Count != 10 {
+		t.Errorf("Count = %v, want 10", d.Count)
+	}
+	for _, f := range []struct {
+		name      string
+		got, want float64
+	}{
+		{"Mean", d.Mean, 4.3},
+		{"StdDev", d.StdDev, math.Sqrt(2.2333333333333333)},
+		{"Min", d.Min, 2},
+		{"Q1", d.Q1, s.Quantile(0.25)},
+		{"Median", d.Median, 4},
+		{"Q3", d.Q3, s.Quantile(0.75)},
+		{"Max", d.Max, 7},
+		{"Skewness", d.Skewness, Skewness(orig)},
+		{"Kurtosis", d.Kurtosis, Kurtosis(orig)},
+	} {
+		if !aeq(f.got, f.want) {
+			t.Errorf("%s = %v, want %v", f.name, f.got, f.want)
+		}
+	}
+
+	want := `count    10
+mean     4.3
+stddev   1.49443
+min      2
+25%      3
+median   4
+75%      5.08333
+max      7
+skewness 0.359543
+kurtosis -0.1518
+`
+	if got := d.String(); got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+
+	empty := Describe(nil)
+	if empty.Count != 0 || !math.IsNaN(empty.Mean) || !math.IsNaN(empty.Median) || !math.IsNaN(empty.Kurtosis) {
+		t.Errorf("Describe(nil) = %+v, want Count 0 and NaN statistics", empty)
+	}
+}
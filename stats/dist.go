@@ -4,7 +4,10 @@
 
 package stats
 
-import "math/rand"
+import (
+	"math"
+	"math/rand"
+)
 
 // A DistCommon is a statistical distribution. DistCommon is a base
 // interface provided by both continuous and discrete distributions.
@@ -76,6 +79,38 @@ type DiscreteDist interface {
 	Step() float64
 }
 
+// Moments is implemented by distributions with known moments.
+//
+// Distributions that implement Moments may also implement
+//
+//	Skewness() float64
+//	ExcessKurtosis() float64
+//
+// where ExcessKurtosis is the kurtosis minus 3, so the excess
+// kurtosis of a normal distribution is 0.
+//
+// If a moment of the distribution diverges to infinity, the
+// corresponding method returns +Inf. If it is otherwise undefined,
+// the method returns NaN.
+type Moments interface {
+	Mean() float64
+	Variance() float64
+}
+
+// discreteEntropy returns the Shannon entropy of dist in nats,
+// computed by summing -PMF(x)*log(PMF(x)) over dist.Bounds().
+func discreteEntropy(dist DiscreteDist) float64 {
+	l, h := dist.Bounds()
+	s := dist.Step()
+	var H float64
+	for x := l; x <= h; x += s {
+		if p := dist.PMF(x); p > 0 {
+			H -= p * math.Log(p)
+		}
+	}
+	return H
+}
+
 // TODO: Add a Support method for finite support distributions? Or
 // maybe just another return value from Bounds indicating that the
 // bounds are exact?
@@ -178,6 +213,29 @@ func InvCDF(dist DistCommon) func(y float64) (x float64) {
 	}
 }
 
+// Median returns the median of dist.
+//
+// If dist implements Median() float64, this returns the result of
+// that method. Otherwise it returns InvCDF(dist)(0.5). For discrete
+// distributions, this is the smallest x on the distribution such that
+// CDF(x) >= 0.5.
+func Median(dist DistCommon) float64 {
+	type median interface {
+		Median() float64
+	}
+	if dist, ok := dist.(median); ok {
+		return dist.Median()
+	}
+	x := InvCDF(dist)(0.5)
+	if dist, ok := dist.(DiscreteDist); ok {
+		// The generic InvCDF may land just past the point at
+		// which the CDF steps up. Round down to that point.
+		s := dist.Step()
+		x = math.Floor(x/s) * s
+	}
+	return x
+}
+
 // Rand returns a random number generator that draws from the given
 // distribution. The returned generator takes an optional source of
 // randomness; if this is nil, it uses the default global source.
@@ -208,3 +266,22 @@ func Rand(dist DistCommon) func(*rand.Rand) float64 {
 		return inv(y)
 	}
 }
+
+// SampleN returns n random samples drawn from dist using r. If r is
+// nil, it uses the default global source. See Rand for how samples
+// are drawn.
+func SampleN(dist DistCommon, n int, r *rand.Rand) []float64 {
+	return SampleInto(dist, make([]float64, n), r)
+}
+
+// SampleInto fills dst with random samples drawn from dist using r
+// and returns dst. If r is nil, it uses the default global source.
+// This is like SampleN, but reuses dst rather than allocating a new
+// slice.
+func SampleInto(dist DistCommon, dst []float64, r *rand.Rand) []float64 {
+	rnd := Rand(dist)
+	for i := range dst {
+		dst[i] = rnd(r)
+	}
+	return dst
+}
@@ -6,7 +6,11 @@ package stats
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
 )
 
 type funnyCDF struct {
@@ -46,3 +50,242 @@ func TestInvCDF(t *testing.T) {
 			})
 	}
 }
+
+func TestMoments(t *testing.T) {
+	type higherMoments interface {
+		Skewness() float64
+		ExcessKurtosis() float64
+	}
+	for _, c := range []struct {
+		dist                           Moments
+		mean, variance, skew, exKurtos float64
+	}{
+		{NormalDist{2, 3}, 2, 9, 0, 0},
+
+		{TDist{1}, nan, nan, nan, nan},
+		{TDist{2}, 0, inf, nan, nan},
+		{TDist{3}, 0, 3, nan, inf},
+		{TDist{4}, 0, 2, 0, inf},
+		{TDist{10}, 0, 1.25, 0, 1},
+
+		{FDist{5, 2}, inf, nan, nan, nan},
+		{FDist{5, 4}, 2, inf, nan, nan},
+		{FDist{4, 10}, 1.25, 2 * 100 * 12 / (4 * 64 * 6.0), 16 * math.Sqrt(8*6) / (4 * math.Sqrt(4*12)), 12 * (4*28*12 + 6*64) / (4 * 4 * 2 * 12.0)},
+
+		{ChiSquaredDist{2}, 2, 4, 2, 6},
+		{ChiSquaredDist{8}, 8, 16, 1, 1.5},
+
+		{BinomialDist{10, 0.5}, 5, 2.5, 0, -0.2},
+		{BinomialDist{4, 0.2}, 0.8, 0.64, 0.6 / 0.8, (1 - 6*0.16) / 0.64},
+	} {
+		check := func(name string, got, want float64) {
+			t.Helper()
+			if !(math.IsNaN(want) && math.IsNaN(got) || aeq(want, got)) {
+				t.Errorf("%+v.%s() = %v, want %v", c.dist, name, got, want)
+			}
+		}
+		check("Mean", c.dist.Mean(), c.mean)
+		check("Variance", c.dist.Variance(), c.variance)
+		hm, ok := c.dist.(higherMoments)
+		if !ok {
+			t.Errorf("%+v does not implement Skewness and ExcessKurtosis", c.dist)
+			continue
+		}
+		check("Skewness", hm.Skewness(), c.skew)
+		check("ExcessKurtosis", hm.ExcessKurtosis(), c.exKurtos)
+	}
+}
+
+var randTestDists = []DistCommon{
+	BernoulliDist{0.3},
+	BetaDist{2, 5},
+	BinomialDist{10, 0.4},
+	BinomialDist{100, 0.2},
+	CauchyDist{1, 2},
+	ChiSquaredDist{3},
+	DeltaDist{2},
+	ExponentialDist{2},
+	FDist{5, 10},
+	GammaDist{0.5, 2},
+	GeometricDist{0.25},
+	GumbelDist{1, 2},
+	HypergeometricDist{N: 50, K: 20, Draws: 10},
+	LaplaceDist{1, 2},
+	LogNormalDist{0, 0.5},
+	NegativeBinomialDist{3, 0.4},
+	NormalDist{1, 2},
+	ParetoDist{1, 3},
+	TDist{5},
+	UniformDist{-1, 3},
+	WeibullDist{1.5, 2},
+}
+
+func TestRandReproducible(t *testing.T) {
+	for _, d := range randTestDists {
+		if _, ok := d.(interface{ Rand(*rand.Rand) float64 }); !ok {
+			t.Errorf("%T has no Rand method", d)
+			continue
+		}
+		rand1 := Rand(d)
+		r1 := rand.New(rand.NewSource(42))
+		r2 := rand.New(rand.NewSource(42))
+		for i := 0; i < 100; i++ {
+			x1, x2 := rand1(r1), rand1(r2)
+			if x1 != x2 && !(math.IsNaN(x1) && math.IsNaN(x2)) {
+				t.Errorf("%+v: sample %d differs between identically seeded generators: %v != %v", d, i, x1, x2)
+				break
+			}
+		}
+	}
+}
+
+func TestRandMoments(t *testing.T) {
+	for _, d := range randTestDists {
+		m, ok := d.(Moments)
+		if !ok || math.IsInf(m.Variance(), 0) || math.IsNaN(m.Variance()) {
+			continue
+		}
+		r := rand.New(rand.NewSource(1))
+		rnd := Rand(d)
+		const n = 20000
+		var s StreamStats
+		for i := 0; i < n; i++ {
+			s.Add(rnd(r))
+		}
+		// Allow 5 standard errors of the mean.
+		tol := 5 * math.Sqrt(m.Variance()/n)
+		if math.Abs(s.Mean()-m.Mean()) > tol {
+			t.Errorf("%+v: sample mean %v, want %v ± %v", d, s.Mean(), m.Mean(), tol)
+		}
+	}
+}
+
+func TestSampleN(t *testing.T) {
+	d := NormalDist{1, 2}
+	xs := SampleN(d, 100, rand.New(rand.NewSource(1)))
+	if len(xs) != 100 {
+		t.Fatalf("len(SampleN(%+v, 100)) = %d", d, len(xs))
+	}
+	r := rand.New(rand.NewSource(1))
+	for i, x := range xs {
+		if want := d.Rand(r); x != want {
+			t.Fatalf("SampleN(%+v)[%d] = %v, want %v", d, i, x, want)
+		}
+	}
+
+	buf := make([]float64, 100)
+	ys := SampleInto(d, buf, rand.New(rand.NewSource(1)))
+	if &ys[0] != &buf[0] {
+		t.Errorf("SampleInto did not reuse dst")
+	}
+	for i := range xs {
+		if xs[i] != ys[i] {
+			t.Fatalf("SampleInto(%+v)[%d] = %v, want %v", d, i, ys[i], xs[i])
+		}
+	}
+}
+
+func BenchmarkSampleInto(b *testing.B) {
+	d := NormalDist{0, 1}
+	r := rand.New(rand.NewSource(1))
+	buf := make([]float64, 1000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		SampleInto(d, buf, r)
+	}
+}
+
+func BenchmarkSampleAppend(b *testing.B) {
+	d := NormalDist{0, 1}
+	r := rand.New(rand.NewSource(1))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var xs []float64
+		for j := 0; j < 1000; j++ {
+			xs = append(xs, d.Rand(r))
+		}
+	}
+}
+
+func TestMode(t *testing.T) {
+	type moder interface {
+		Mode() float64
+	}
+	for _, d := range []Dist{
+		NormalDist{1, 2},
+		ExponentialDist{3},
+		GammaDist{3, 2},
+		GammaDist{1, 2},
+		GammaDist{0.5, 2},
+		BetaDist{2, 5},
+		BetaDist{5, 2},
+		BetaDist{0.5, 3},
+		BetaDist{3, 0.5},
+		BetaDist{0.5, 0.5},
+		BetaDist{1, 1},
+		BetaDist{1, 3},
+		BetaDist{3, 1},
+	} {
+		mode := d.(moder).Mode()
+		// Check that no point on a fine grid has greater density.
+		lo, hi := d.Bounds()
+		pm := d.PDF(mode)
+		for _, x := range vec.Linspace(lo, hi, 10001) {
+			if px := d.PDF(x); px > pm*(1+1e-9) {
+				t.Errorf("%+v.Mode() = %v with density %v, but PDF(%v) = %v", d, mode, pm, x, px)
+				break
+			}
+		}
+	}
+
+	cat, err := NewCategoricalDist([]float64{1, 4, 2, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []DiscreteDist{
+		BernoulliDist{0.3},
+		BernoulliDist{0.7},
+		BinomialDist{10, 0.3},
+		BinomialDist{9, 0.3},
+		BinomialDist{10, 1},
+		BinomialDist{10, 0},
+		GeometricDist{0.3},
+		NegativeBinomialDist{5, 0.3},
+		NegativeBinomialDist{0.5, 0.3},
+		HypergeometricDist{N: 50, K: 20, Draws: 10},
+		HypergeometricDist{N: 10, K: 4, Draws: 5},
+		cat,
+	} {
+		mode := d.(moder).Mode()
+		lo, hi := d.Bounds()
+		pm := d.PMF(mode)
+		for x := lo; x <= hi; x += d.Step() {
+			if px := d.PMF(x); px > pm*(1+1e-9) {
+				t.Errorf("%+v.Mode() = %v with mass %v, but PMF(%v) = %v", d, mode, pm, x, px)
+				break
+			}
+		}
+	}
+	if got := cat.Mode(); got != 1 {
+		t.Errorf("CategoricalDist.Mode() = %v, want 1", got)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	for _, d := range randTestDists {
+		got := Median(d)
+		var want float64
+		if dd, ok := d.(DiscreteDist); ok {
+			// Find the smallest x with CDF(x) >= 0.5.
+			want, _ = dd.Bounds()
+			for dd.CDF(want) < 0.5 {
+				want += dd.Step()
+			}
+		} else {
+			want = InvCDF(d)(0.5)
+		}
+		if !aeq(want, got) {
+			t.Errorf("Median(%+v) = %v, want %v", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Distributions marshal to JSON objects with a "type" field naming
+// the distribution and one field for each parameter, for example
+//
+//	{"type":"normal","mu":0,"sigma":1}
+//
+// UnmarshalDist decodes any of these without knowing the type in
+// advance.
+
+type distJSONType struct {
+	Type string `json:"type"`
+}
+
+type normalDistJSON struct {
+	Type  string  `json:"type"`
+	Mu    float64 `json:"mu"`
+	Sigma float64 `json:"sigma"`
+}
+
+type tDistJSON struct {
+	Type string  `json:"type"`
+	V    float64 `json:"v"`
+}
+
+type fDistJSON struct {
+	Type string  `json:"type"`
+	D1   float64 `json:"d1"`
+	D2   float64 `json:"d2"`
+}
+
+type chiSquaredDistJSON struct {
+	Type string  `json:"type"`
+	DF   float64 `json:"df"`
+}
+
+type binomialDistJSON struct {
+	Type string  `json:"type"`
+	N    int     `json:"n"`
+	P    float64 `json:"p"`
+}
+
+// unmarshalDistJSON decodes data into v and checks that its "type"
+// field is want.
+func unmarshalDistJSON(data []byte, v interface{}, typ *string, want string) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	if *typ != want {
+		return fmt.Errorf("cannot unmarshal %q distribution into %s distribution", *typ, want)
+	}
+	return nil
+}
+
+func (n NormalDist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(normalDistJSON{"normal", n.Mu, n.Sigma})
+}
+
+func (n *NormalDist) UnmarshalJSON(data []byte) error {
+	var j normalDistJSON
+	if err := unmarshalDistJSON(data, &j, &j.Type, "normal"); err != nil {
+		return err
+	}
+	*n = NormalDist{j.Mu, j.Sigma}
+	return nil
+}
+
+func (t TDist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tDistJSON{"t", t.V})
+}
+
+func (t *TDist) UnmarshalJSON(data []byte) error {
+	var j tDistJSON
+	if err := unmarshalDistJSON(data, &j, &j.Type, "t"); err != nil {
+		return err
+	}
+	*t = TDist{j.V}
+	return nil
+}
+
+func (f FDist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fDistJSON{"f", f.D1, f.D2})
+}
+
+func (f *FDist) UnmarshalJSON(data []byte) error {
+	var j fDistJSON
+	if err := unmarshalDistJSON(data, &j, &j.Type, "f"); err != nil {
+		return err
+	}
+	*f = FDist{j.D1, j.D2}
+	return nil
+}
+
+func (c ChiSquaredDist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(chiSquaredDistJSON{"chisquared", c.DF})
+}
+
+func (c *ChiSquaredDist) UnmarshalJSON(data []byte) error {
+	var j chiSquaredDistJSON
+	if err := unmarshalDistJSON(data, &j, &j.Type, "chisquared"); err != nil {
+		return err
+	}
+	*c = ChiSquaredDist{j.DF}
+	return nil
+}
+
+func (d BinomialDist) MarshalJSON() ([]byte, error) {
+	return json.Marshal(binomialDistJSON{"binomial", d.N, d.P})
+}
+
+func (d *BinomialDist) UnmarshalJSON(data []byte) error {
+	var j binomialDistJSON
+	if err := unmarshalDistJSON(data, &j, &j.Type, "binomial"); err != nil {
+		return err
+	}
+	*d = BinomialDist{j.N, j.P}
+	return nil
+}
+
+// UnmarshalDist decodes a distribution marshaled by one of the
+// distribution MarshalJSON methods, using its "type" field to
+// determine the distribution type. The result is a value of the
+// distribution type, such as NormalDist.
+func UnmarshalDist(data []byte) (DistCommon, error) {
+	var t distJSONType
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	switch t.Type {
+	case "normal":
+		var d NormalDist
+		err := d.UnmarshalJSON(data)
+		return d, err
+	case "t":
+		var d TDist
+		err := d.UnmarshalJSON(data)
+		return d, err
+	case "f":
+		var d FDist
+		err := d.UnmarshalJSON(data)
+		return d, err
+	case "chisquared":
+		var d ChiSquaredDist
+		err := d.UnmarshalJSON(data)
+		return d, err
+	case "binomial":
+		var d BinomialDist
+		err := d.UnmarshalJSON(data)
+		return d, err
+	}
+	return nil, fmt.Errorf("unknown distribution type %q", t.Type)
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDistJSON(t *testing.T) {
+	for _, c := range []struct {
+		dist DistCommon
+		json string
+	}{
+		{NormalDist{1.5, 2}, `{"type":"normal","mu":1.5,"sigma":2}`},
+		{TDist{3}, `{"type":"t","v":3}`},
+		{FDist{2, 4.5}, `{"type":"f","d1":2,"d2":4.5}`},
+		{ChiSquaredDist{7}, `{"type":"chisquared","df":7}`},
+		{BinomialDist{10, 0.25}, `{"type":"binomial","n":10,"p":0.25}`},
+	} {
+		data, err := json.Marshal(c.dist)
+		if err != nil {
+			t.Errorf("Marshal(%+v): %v", c.dist, err)
+			continue
+		}
+		if string(data) != c.json {
+			t.Errorf("Marshal(%+v) = %s, want %s", c.dist, data, c.json)
+		}
+
+		// Round-trip through the concrete type.
+		ptr := reflect.New(reflect.TypeOf(c.dist))
+		if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+			t.Errorf("Unmarshal(%s): %v", data, err)
+		} else if got := ptr.Elem().Interface(); got != c.dist {
+			t.Errorf("Unmarshal(%s) = %+v, want %+v", data, got, c.dist)
+		}
+
+		// Round-trip without knowing the type.
+		got, err := UnmarshalDist(data)
+		if err != nil {
+			t.Errorf("UnmarshalDist(%s): %v", data, err)
+		} else if got != c.dist {
+			t.Errorf("UnmarshalDist(%s) = %#v, want %#v", data, got, c.dist)
+		}
+	}
+
+	// Distributions nested in other values use the same format.
+	type model struct {
+		Name string
+		Dist NormalDist
+	}
+	data, err := json.Marshal(model{"m", StdNormal})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Name":"m","Dist":{"type":"normal","mu":0,"sigma":1}}`; string(data) != want {
+		t.Errorf("Marshal(model) = %s, want %s", data, want)
+	}
+}
+
+func TestDistJSONErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"type":"cauchy","x0":0}`,
+		`{"mu":0,"sigma":1}`,
+		`[1, 2]`,
+		`{`,
+	} {
+		if d, err := UnmarshalDist([]byte(data)); err == nil {
+			t.Errorf("UnmarshalDist(%s) = %+v, want error", data, d)
+		}
+	}
+
+	var n NormalDist
+	if err := json.Unmarshal([]byte(`{"type":"t","v":3}`), &n); err == nil {
+		t.Errorf("Unmarshal of t distribution into NormalDist succeeded")
+	}
+}
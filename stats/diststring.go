@@ -0,0 +1,113 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "fmt"
+
+// String methods for distributions. These format the distribution
+// and its parameters in conventional notation.
+
+func (d BernoulliDist) String() string {
+	return fmt.Sprintf("Bernoulli(p=%g)", d.P)
+}
+
+func (d BetaDist) String() string {
+	return fmt.Sprintf("Beta(alpha=%g, beta=%g)", d.Alpha, d.Beta)
+}
+
+func (d BinomialDist) String() string {
+	return fmt.Sprintf("Binomial(n=%d, p=%g)", d.N, d.P)
+}
+
+func (d *CategoricalDist) String() string {
+	return fmt.Sprintf("Categorical(p=%v)", d.p)
+}
+
+func (d CauchyDist) String() string {
+	return fmt.Sprintf("Cauchy(x0=%g, gamma=%g)", d.X0, d.Gamma)
+}
+
+func (c ChiSquaredDist) String() string {
+	return fmt.Sprintf("ChiSquared(df=%g)", c.DF)
+}
+
+func (d DeltaDist) String() string {
+	return fmt.Sprintf("Delta(t=%g)", d.T)
+}
+
+func (d ExponentialDist) String() string {
+	return fmt.Sprintf("Exponential(rate=%g)", d.Rate)
+}
+
+func (f FDist) String() string {
+	return fmt.Sprintf("F(d1=%g, d2=%g)", f.D1, f.D2)
+}
+
+func (d GammaDist) String() string {
+	return fmt.Sprintf("Gamma(shape=%g, scale=%g)", d.Shape, d.Scale)
+}
+
+func (d GeometricDist) String() string {
+	return fmt.Sprintf("Geometric(p=%g)", d.P)
+}
+
+func (d GumbelDist) String() string {
+	return fmt.Sprintf("Gumbel(mu=%g, beta=%g)", d.Mu, d.Beta)
+}
+
+func (d HypergeometricDist) String() string {
+	return fmt.Sprintf("Hypergeometric(n=%d, k=%d, draws=%d)", d.N, d.K, d.Draws)
+}
+
+func (kde *KDE) String() string {
+	return fmt.Sprintf("KDE(n=%d, kernel=%v, bandwidth=%g)", len(kde.Sample.Xs), kde.Kernel, kde.Bandwidth)
+}
+
+func (d LaplaceDist) String() string {
+	return fmt.Sprintf("Laplace(mu=%g, b=%g)", d.Mu, d.B)
+}
+
+func (d LogNormalDist) String() string {
+	return fmt.Sprintf("LogNormal(mu=%g, sigma=%g)", d.Mu, d.Sigma)
+}
+
+func (d NegativeBinomialDist) String() string {
+	return fmt.Sprintf("NegativeBinomial(r=%g, p=%g)", d.R, d.P)
+}
+
+func (n NormalDist) String() string {
+	return fmt.Sprintf("N(mu=%g, sigma=%g)", n.Mu, n.Sigma)
+}
+
+func (d ParetoDist) String() string {
+	return fmt.Sprintf("Pareto(xm=%g, alpha=%g)", d.Xm, d.Alpha)
+}
+
+func (d StudentizedRangeDist) String() string {
+	return fmt.Sprintf("StudentizedRange(k=%d, df=%g)", d.K, d.DF)
+}
+
+func (t TDist) String() string {
+	return fmt.Sprintf("T(v=%g)", t.V)
+}
+
+func (d UDist) String() string {
+	if d.T == nil {
+		return fmt.Sprintf("UDist(n1=%d, n2=%d)", d.N1, d.N2)
+	}
+	return fmt.Sprintf("UDist(n1=%d, n2=%d, ties=%v)", d.N1, d.N2, d.T)
+}
+
+func (d UniformDist) String() string {
+	return fmt.Sprintf("Uniform(lo=%g, hi=%g)", d.Lo, d.Hi)
+}
+
+func (d WeibullDist) String() string {
+	return fmt.Sprintf("Weibull(k=%g, lambda=%g)", d.K, d.Lambda)
+}
+
+func (e *ECDF) String() string {
+	return fmt.Sprintf("ECDF(%d points)", len(e.xs))
+}
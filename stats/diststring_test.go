@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDistString(t *testing.T) {
+	cat, err := NewCategoricalDist([]float64{1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		dist fmt.Stringer
+		want string
+	}{
+		{BernoulliDist{0.5}, "Bernoulli(p=0.5)"},
+		{BetaDist{2, 5}, "Beta(alpha=2, beta=5)"},
+		{BinomialDist{10, 0.25}, "Binomial(n=10, p=0.25)"},
+		{cat, "Categorical(p=[0.25 0.75])"},
+		{CauchyDist{0, 1}, "Cauchy(x0=0, gamma=1)"},
+		{ChiSquaredDist{3}, "ChiSquared(df=3)"},
+		{DeltaDist{1.5}, "Delta(t=1.5)"},
+		{NewECDF(Sample{Xs: []float64{1, 2, 2}}), "ECDF(2 points)"},
+		{ExponentialDist{2}, "Exponential(rate=2)"},
+		{FDist{2, 4}, "F(d1=2, d2=4)"},
+		{GammaDist{2, 3}, "Gamma(shape=2, scale=3)"},
+		{GeometricDist{0.1}, "Geometric(p=0.1)"},
+		{GumbelDist{0, 1}, "Gumbel(mu=0, beta=1)"},
+		{HypergeometricDist{50, 5, 10}, "Hypergeometric(n=50, k=5, draws=10)"},
+		{&KDE{Sample: Sample{Xs: []float64{1, 2}}, Kernel: GaussianKernel, Bandwidth: 0.5}, "KDE(n=2, kernel=GaussianKernel, bandwidth=0.5)"},
+		{LaplaceDist{0, 2}, "Laplace(mu=0, b=2)"},
+		{LogNormalDist{0, 1}, "LogNormal(mu=0, sigma=1)"},
+		{NegativeBinomialDist{3, 0.5}, "NegativeBinomial(r=3, p=0.5)"},
+		{StdNormal, "N(mu=0, sigma=1)"},
+		{NormalDist{1e6, 0.001}, "N(mu=1e+06, sigma=0.001)"},
+		{ParetoDist{1, 3}, "Pareto(xm=1, alpha=3)"},
+		{StudentizedRangeDist{3, 10}, "StudentizedRange(k=3, df=10)"},
+		{TDist{5}, "T(v=5)"},
+		{UDist{N1: 3, N2: 4}, "UDist(n1=3, n2=4)"},
+		{UDist{N1: 2, N2: 2, T: []int{2, 1, 1}}, "UDist(n1=2, n2=2, ties=[2 1 1])"},
+		{UniformDist{0, 1}, "Uniform(lo=0, hi=1)"},
+		{WeibullDist{1.5, 2}, "Weibull(k=1.5, lambda=2)"},
+	} {
+		if got := c.dist.String(); got != c.want {
+			t.Errorf("String() = %q, want %q", got, c.want)
+		}
+		if got := fmt.Sprint(c.dist); got != c.want {
+			t.Errorf("Sprint = %q, want %q", got, c.want)
+		}
+	}
+}
+
+func TestResultString(t *testing.T) {
+	r, err := TwoSampleTTest(Sample{Xs: []float64{1, 2, 3, 4}}, Sample{Xs: []float64{3, 4, 5, 6}}, LocationLess)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "N1=4 N2=4 T=-2.191 DoF=6 P=0.03549 D=-1.549 AltHypothesis=LocationLess"
+	if got := r.String(); got != want {
+		t.Errorf("TTestResult.String() = %q, want %q", got, want)
+	}
+	if got := fmt.Sprint(*r); got != want {
+		t.Errorf("Sprint(TTestResult) = %q, want %q", got, want)
+	}
+
+	u, err := MannWhitneyUTest([]float64{1, 2, 3}, []float64{4, 5, 6}, LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = "N1=3 N2=3 U=0 P=0.1 AltHypothesis=LocationDiffers"
+	if got := u.String(); got != want {
+		t.Errorf("MannWhitneyUTestResult.String() = %q, want %q", got, want)
+	}
+}
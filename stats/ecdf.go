@@ -0,0 +1,110 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// ECDF is the empirical cumulative distribution function of a
+// sample. This is a step function that jumps by the weight of each
+// value in the sample.
+//
+// ECDF should be constructed with NewECDF. An ECDF implements
+// DistCommon, so it can be used, for example, to compare another
+// sample against it with KolmogorovSmirnovTest.
+type ECDF struct {
+	// xs are the distinct values of the sample with non-zero
+	// weight, in increasing order.
+	xs []float64
+
+	// cdf[i] is the fraction of the total weight of the sample
+	// at values <= xs[i].
+	cdf []float64
+}
+
+// NewECDF returns the empirical CDF of s. Values with zero weight are
+// ignored. The ECDF does not retain s.
+func NewECDF(s Sample) *ECDF {
+	if !s.Sorted {
+		s = *s.Copy().Sort()
+	}
+	e := &ECDF{}
+	total := 0.0
+	for i, x := range s.Xs {
+		w := 1.0
+		if s.Weights != nil {
+			w = s.Weights[i]
+			if w == 0 {
+				continue
+			}
+		}
+		total += w
+		if n := len(e.xs); n > 0 && e.xs[n-1] == x {
+			e.cdf[n-1] = total
+		} else {
+			e.xs = append(e.xs, x)
+			e.cdf = append(e.cdf, total)
+		}
+	}
+	for i := range e.cdf {
+		e.cdf[i] /= total
+	}
+	if len(e.cdf) > 0 {
+		e.cdf[len(e.cdf)-1] = 1
+	}
+	return e
+}
+
+// Eval returns the fraction of the weight of the sample at values
+// <= x. If the sample is empty, Eval returns NaN.
+//
+// This takes O(log n) time in the number of distinct values.
+func (e *ECDF) Eval(x float64) float64 {
+	if len(e.xs) == 0 {
+		return math.NaN()
+	}
+	// Find the number of distinct values <= x.
+	i := sort.Search(len(e.xs), func(i int) bool { return e.xs[i] > x })
+	if i == 0 {
+		return 0
+	}
+	return e.cdf[i-1]
+}
+
+// CDF is the same as Eval. It allows an ECDF to be used as a
+// DistCommon.
+func (e *ECDF) CDF(x float64) float64 {
+	return e.Eval(x)
+}
+
+// Quantile returns the smallest value x of the sample such that
+// Eval(x) >= p. This is the inverse of the empirical CDF (method R1
+// of Hyndman and Fan (1996)) and does not interpolate.
+//
+// p will be capped to the range [0, 1]. If the sample is empty or p
+// is NaN, Quantile returns NaN.
+//
+// This takes O(log n) time in the number of distinct values.
+func (e *ECDF) Quantile(p float64) float64 {
+	if len(e.xs) == 0 || math.IsNaN(p) {
+		return math.NaN()
+	}
+	i := sort.SearchFloat64s(e.cdf, p)
+	if i >= len(e.xs) {
+		i = len(e.xs) - 1
+	}
+	return e.xs[i]
+}
+
+// Bounds returns the smallest and largest values in the sample. If
+// the sample is empty, it returns NaN, NaN.
+func (e *ECDF) Bounds() (float64, float64) {
+	if len(e.xs) == 0 {
+		return math.NaN(), math.NaN()
+	}
+	return e.xs[0], e.xs[len(e.xs)-1]
+}
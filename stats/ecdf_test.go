@@ -0,0 +1,82 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestECDF(t *testing.T) {
+	e := NewECDF(Sample{Xs: []float64{3, 1, 2, 2, 5}})
+	testFunc(t, "ECDF.Eval", e.Eval, map[float64]float64{
+		// Outside the data.
+		-inf: 0,
+		0:    0,
+		6:    1,
+		inf:  1,
+		// At the data points.
+		1: 0.2,
+		2: 0.6,
+		3: 0.8,
+		5: 1,
+		// Between the data points.
+		1.5: 0.2,
+		2.9: 0.6,
+		4:   0.8,
+	})
+	testFunc(t, "ECDF.Quantile", e.Quantile, map[float64]float64{
+		-1:   1,
+		0:    1,
+		0.2:  1,
+		0.21: 2,
+		0.6:  2,
+		0.7:  3,
+		0.9:  5,
+		1:    5,
+		2:    5,
+	})
+	if lo, hi := e.Bounds(); lo != 1 || hi != 5 {
+		t.Errorf("ECDF.Bounds() = %v, %v, want 1, 5", lo, hi)
+	}
+
+	// Weights scale the jumps, and zero-weight values are ignored.
+	w := NewECDF(Sample{Xs: []float64{1, 2, 3, 4}, Weights: []float64{1, 3, 0, 4}})
+	testFunc(t, "weighted ECDF.Eval", w.Eval, map[float64]float64{
+		0:   0,
+		1:   0.125,
+		2:   0.5,
+		3:   0.5,
+		3.5: 0.5,
+		4:   1,
+	})
+	if lo, hi := w.Bounds(); lo != 1 || hi != 4 {
+		t.Errorf("weighted ECDF.Bounds() = %v, %v, want 1, 4", lo, hi)
+	}
+	if got := w.Quantile(0.75); got != 4 {
+		t.Errorf("weighted ECDF.Quantile(0.75) = %v, want 4", got)
+	}
+
+	empty := NewECDF(Sample{})
+	if got := empty.Eval(0); !math.IsNaN(got) {
+		t.Errorf("empty ECDF.Eval(0) = %v, want NaN", got)
+	}
+	if got := empty.Quantile(0.5); !math.IsNaN(got) {
+		t.Errorf("empty ECDF.Quantile(0.5) = %v, want NaN", got)
+	}
+}
+
+func TestECDFKSTest(t *testing.T) {
+	// A sample compared against its own ECDF should have D equal
+	// to the largest jump, which is 1/n for distinct values.
+	xs := []float64{0.3, 1.7, -0.4, 2.2, 0.9}
+	res, err := KolmogorovSmirnovTest(xs, NewECDF(Sample{Xs: xs}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aeq(res.D, 0.2) {
+		t.Errorf("KolmogorovSmirnovTest against own ECDF: D = %v, want 0.2", res.D)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ExponentialDist is an exponential distribution with rate Rate.
+//
+// This is the distribution of the time between events in a Poisson
+// process with rate Rate. It has support [0, ∞).
+type ExponentialDist struct {
+	// Rate is the rate parameter, usually written λ. Rate > 0.
+	Rate float64
+}
+
+func (d ExponentialDist) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return d.Rate * math.Exp(-d.Rate*x)
+}
+
+func (d ExponentialDist) CDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	}
+	return -math.Expm1(-d.Rate * x)
+}
+
+func (d ExponentialDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 {
+		return nan
+	} else if p == 0 {
+		return 0
+	} else if p == 1 {
+		return inf
+	}
+	return -math.Log1p(-p) / d.Rate
+}
+
+func (d ExponentialDist) Rand(r *rand.Rand) float64 {
+	var x float64
+	if r == nil {
+		x = rand.ExpFloat64()
+	} else {
+		x = r.ExpFloat64()
+	}
+	return x / d.Rate
+}
+
+func (d ExponentialDist) Bounds() (float64, float64) {
+	return 0, d.InvCDF(0.999)
+}
+
+func (d ExponentialDist) Mean() float64 {
+	return 1 / d.Rate
+}
+
+func (d ExponentialDist) Variance() float64 {
+	return 1 / (d.Rate * d.Rate)
+}
+
+func (d ExponentialDist) Mode() float64 {
+	return 0
+}
+
+func (d ExponentialDist) Median() float64 {
+	return math.Ln2 / d.Rate
+}
+
+// Entropy returns the differential entropy of d in nats.
+func (d ExponentialDist) Entropy() float64 {
+	return 1 - math.Log(d.Rate)
+}
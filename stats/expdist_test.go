@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestExponentialDist(t *testing.T) {
+	d := ExponentialDist{Rate: 2}
+
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-1:  0,
+		0:   2,
+		0.5: 2 * math.Exp(-1),
+		1:   2 * math.Exp(-2),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-1:  0,
+		0:   0,
+		0.5: 1 - math.Exp(-1),
+		1:   1 - math.Exp(-2),
+		inf: 1,
+	})
+	testFunc(t, fmt.Sprintf("%+v.InvCDF", d), d.InvCDF, map[float64]float64{
+		-0.1: nan,
+		0:    0,
+		0.5:  math.Ln2 / 2,
+		1:    inf,
+		1.1:  nan,
+	})
+	for _, p := range vec.Linspace(0, 1, 11) {
+		if got := d.CDF(d.InvCDF(p)); !aeq(p, got) {
+			t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+		}
+	}
+	testPDFIntegral(t, d, vec.Linspace(0, 4, 9))
+
+	if m := d.Mean(); m != 0.5 {
+		t.Errorf("%+v.Mean() = %v, want 0.5", d, m)
+	}
+	if v := d.Variance(); v != 0.25 {
+		t.Errorf("%+v.Variance() = %v, want 0.25", d, v)
+	}
+}
+
+func TestExponentialEntropy(t *testing.T) {
+	for _, d := range []ExponentialDist{{1}, {0.5}, {4}} {
+		want := 1 - math.Log(d.Rate)
+		if got := d.Entropy(); !aeq(want, got) {
+			t.Errorf("%+v.Entropy() = %v, want %v", d, got, want)
+		}
+		testEntropy(t, d, 0, 50/d.Rate)
+	}
+}
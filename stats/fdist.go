@@ -0,0 +1,144 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/jgbaldwinbrown/go-moremath/mathx"
+)
+
+// FDist is an F-distribution (also known as the Fisher–Snedecor
+// distribution) with D1 and D2 degrees of freedom. It has support
+// [0, ∞).
+//
+// This is the distribution of the ratio (U1/D1) / (U2/D2), where U1
+// and U2 are independent chi-squared random variables with D1 and D2
+// degrees of freedom, respectively. It arises as the null
+// distribution of the test statistic in an analysis of variance.
+type FDist struct {
+	D1, D2 float64
+}
+
+func (f FDist) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	} else if x == 0 {
+		switch {
+		case f.D1 < 2:
+			return inf
+		case f.D1 == 2:
+			return 1
+		default:
+			return 0
+		}
+	}
+	a, b := f.D1/2, f.D2/2
+	return math.Exp(a*math.Log(f.D1/f.D2) + (a-1)*math.Log(x) -
+		(a+b)*math.Log1p(f.D1*x/f.D2) -
+		lgamma(a) - lgamma(b) + lgamma(a+b))
+}
+
+func (f FDist) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	} else if math.IsInf(x, 1) {
+		return 1
+	}
+	return mathx.BetaInc(f.D1*x/(f.D1*x+f.D2), f.D1/2, f.D2/2)
+}
+
+func (f FDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return nan
+	} else if p == 0 {
+		return 0
+	} else if p == 1 {
+		return inf
+	}
+	// CDF(x) = I_y(D1/2, D2/2) where y = D1*x/(D1*x+D2). Invert
+	// the regularized incomplete beta function to find y, which
+	// has bounded support, and then map y back to x.
+	//
+	// In the upper tail, y is close to 1 and computing 1-y would
+	// lose precision, so instead solve for z = 1-y directly using
+	// I_z(D2/2, D1/2) = 1 - I_y(D1/2, D2/2) = 1 - p.
+	if p > 0.5 {
+		z := betaIncInv(1-p, f.D2/2, f.D1/2)
+		return f.D2 * (1 - z) / (f.D1 * z)
+	}
+	y := betaIncInv(p, f.D1/2, f.D2/2)
+	return f.D2 * y / (f.D1 * (1 - y))
+}
+
+// betaIncInv returns the y in [0, 1] such that mathx.BetaInc(y, a, b)
+// == p.
+func betaIncInv(p, a, b float64) float64 {
+	if p <= 0 {
+		return 0
+	} else if p >= 1 {
+		return 1
+	}
+	_, y := bisectBool(func(y float64) bool {
+		return mathx.BetaInc(y, a, b) < p
+	}, 0, 1, 0)
+	return y
+}
+
+// Rand returns a random sample drawn from f as (W1/D1) / (W2/D2),
+// where W1 and W2 are chi-squared with D1 and D2 degrees of freedom.
+func (f FDist) Rand(r *rand.Rand) float64 {
+	w1 := ChiSquaredDist{f.D1}.Rand(r)
+	w2 := ChiSquaredDist{f.D2}.Rand(r)
+	return (w1 / f.D1) / (w2 / f.D2)
+}
+
+func (f FDist) Bounds() (float64, float64) {
+	return 0, f.InvCDF(0.99)
+}
+
+// Mean returns the mean of f. This is +Inf if D2 <= 2.
+func (f FDist) Mean() float64 {
+	if f.D2 > 2 {
+		return f.D2 / (f.D2 - 2)
+	}
+	return inf
+}
+
+// Variance returns the variance of f. This is +Inf if 2 < D2 <= 4
+// and undefined (NaN) if D2 <= 2.
+func (f FDist) Variance() float64 {
+	d1, d2 := f.D1, f.D2
+	if d2 > 4 {
+		return 2 * d2 * d2 * (d1 + d2 - 2) /
+			(d1 * (d2 - 2) * (d2 - 2) * (d2 - 4))
+	} else if d2 > 2 {
+		return inf
+	}
+	return nan
+}
+
+// Skewness returns the skewness of f. This is undefined (NaN) if
+// D2 <= 6.
+func (f FDist) Skewness() float64 {
+	d1, d2 := f.D1, f.D2
+	if d2 > 6 {
+		return (2*d1 + d2 - 2) * math.Sqrt(8*(d2-4)) /
+			((d2 - 6) * math.Sqrt(d1*(d1+d2-2)))
+	}
+	return nan
+}
+
+// ExcessKurtosis returns the excess kurtosis of f. This is undefined
+// (NaN) if D2 <= 8.
+func (f FDist) ExcessKurtosis() float64 {
+	d1, d2 := f.D1, f.D2
+	if d2 > 8 {
+		return 12 * (d1*(5*d2-22)*(d1+d2-2) + (d2-4)*(d2-2)*(d2-2)) /
+			(d1 * (d2 - 6) * (d2 - 8) * (d1 + d2 - 2))
+	}
+	return nan
+}
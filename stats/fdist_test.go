@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestFDist(t *testing.T) {
+	d := FDist{D1: 2, D2: 4}
+	// With D1=2, D2=4, the CDF is 1 - 1/(1+x/2)² and the PDF is
+	// 1/(1+x/2)³.
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-1: 0,
+		0:  1,
+		1:  1 / math.Pow(1.5, 3),
+		2:  1.0 / 8,
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-1:  0,
+		0:   0,
+		1:   1 - 1/(1.5*1.5),
+		2:   0.75,
+		inf: 1,
+	})
+	testPDFIntegral(t, d, vec.Linspace(0, 10, 11))
+	testPDFIntegral(t, FDist{D1: 6, D2: 10}, vec.Linspace(0, 10, 11))
+}
+
+func TestFDistInvCDF(t *testing.T) {
+	for _, d := range []FDist{{1, 1}, {2, 4}, {5, 10}, {10, 20}, {30, 3}} {
+		testFunc(t, fmt.Sprintf("%+v.InvCDF", d), d.InvCDF, map[float64]float64{
+			-0.1: nan,
+			0:    0,
+			1:    inf,
+			1.1:  nan,
+		})
+		for _, p := range []float64{0.001, 0.01, 0.05, 0.5, 0.95, 0.99, 0.999} {
+			if got := d.CDF(d.InvCDF(p)); math.Abs(got-p) > 1e-12 {
+				t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+			}
+		}
+	}
+
+	// Tabulated upper critical values.
+	for _, c := range []struct{ d1, d2, p, x float64 }{
+		{1, 1, 0.95, 161.44763879758855},
+		{5, 10, 0.95, 3.325834530413011},
+		{10, 20, 0.95, 2.347877566998},
+		{2, 20, 0.99, 5.848932221348262},
+	} {
+		d := FDist{c.d1, c.d2}
+		if got := d.InvCDF(c.p); !aeq(c.x, got) {
+			t.Errorf("%+v.InvCDF(%v) = %v, want %v", d, c.p, got, c.x)
+		}
+	}
+}
+
+func TestFDistInvCDFTails(t *testing.T) {
+	// The CDF of F(1, 1) is (2/π) atan(√x), so its inverse is
+	// tan²(πp/2). In the upper tail, compute this as cot²(π(1-p)/2)
+	// to keep the reference value accurate near the pole.
+	d := FDist{1, 1}
+	for _, p := range []float64{1e-9, 1e-6, 0.001, 0.3, 0.7, 0.999, 0.9999, 0.999999, 1 - 1e-9} {
+		tan := math.Tan(math.Pi * p / 2)
+		if p > 0.5 {
+			tan = 1 / math.Tan(math.Pi*(1-p)/2)
+		}
+		want := tan * tan
+		if got := d.InvCDF(p); math.Abs(got-want) > 1e-10*want {
+			t.Errorf("%+v.InvCDF(%v) = %v, want %v (relative error %v)", d, p, got, want, math.Abs(got-want)/want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// FisherExactTest performs Fisher's exact test on the 2x2
+// contingency table
+//
+//	a b
+//	c d
+//
+// and returns its p-value. Under the null hypothesis that rows and
+// columns are independent, a follows a hypergeometric distribution
+// given the row and column totals.
+//
+// If alt is LocationGreater, the alternative hypothesis is that the
+// odds ratio ad/bc is greater than 1, and the p-value is the
+// probability of a table with a count of at least a in the top left.
+// LocationLess is the reverse. If alt is LocationDiffers, the p-value
+// is the total probability of all tables no more likely than the
+// observed table.
+//
+// This can fail with ErrInvalidTable if any count is negative or
+// ErrSampleSize if all counts are zero.
+func FisherExactTest(a, b, c, d int, alt LocationHypothesis) (float64, error) {
+	if a < 0 || b < 0 || c < 0 || d < 0 {
+		return 0, ErrInvalidTable
+	}
+	n := a + b + c + d
+	if n == 0 {
+		return 0, ErrSampleSize
+	}
+	dist := HypergeometricDist{N: n, K: a + c, Draws: a + b}
+	lo, hi := dist.bounds()
+
+	var p float64
+	switch alt {
+	case LocationLess:
+		for k := lo; k <= a; k++ {
+			p += dist.pmf(k)
+		}
+	case LocationGreater:
+		for k := a; k <= hi; k++ {
+			p += dist.pmf(k)
+		}
+	case LocationDiffers:
+		// Allow for round-off when comparing probabilities,
+		// as R's fisher.test does.
+		pa := dist.pmf(a) * (1 + 1e-7)
+		for k := lo; k <= hi; k++ {
+			if pk := dist.pmf(k); pk <= pa {
+				p += pk
+			}
+		}
+	}
+	return math.Min(p, 1), nil
+}
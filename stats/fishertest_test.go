@@ -0,0 +1,42 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "testing"
+
+func TestFisherExactTest(t *testing.T) {
+	for _, c := range []struct {
+		a, b, c, d int
+		alt        LocationHypothesis
+		p          float64
+	}{
+		// R: fisher.test(matrix(c(1, 11, 9, 3), 2))
+		{1, 9, 11, 3, LocationDiffers, 0.002759456185220083},
+		{1, 9, 11, 3, LocationLess, 0.0013797280926100416},
+		{1, 9, 11, 3, LocationGreater, 0.9999663480953022},
+		// Fisher's lady tasting tea.
+		{3, 1, 1, 3, LocationDiffers, 34.0 / 70},
+		{3, 1, 1, 3, LocationGreater, 17.0 / 70},
+		{3, 1, 1, 3, LocationLess, 69.0 / 70},
+		{10, 2, 3, 15, LocationDiffers, 0.0005367241191434358},
+		{2, 2, 2, 2, LocationDiffers, 1},
+	} {
+		p, err := FisherExactTest(c.a, c.b, c.c, c.d, c.alt)
+		if err != nil {
+			t.Errorf("FisherExactTest(%d, %d, %d, %d, %v): %v", c.a, c.b, c.c, c.d, c.alt, err)
+			continue
+		}
+		if !aeq(p, c.p) {
+			t.Errorf("FisherExactTest(%d, %d, %d, %d, %v) = %v, want %v", c.a, c.b, c.c, c.d, c.alt, p, c.p)
+		}
+	}
+
+	if _, err := FisherExactTest(1, -1, 2, 3, LocationDiffers); err != ErrInvalidTable {
+		t.Errorf("negative count returned error %v, want ErrInvalidTable", err)
+	}
+	if _, err := FisherExactTest(0, 0, 0, 0, LocationDiffers); err != ErrSampleSize {
+		t.Errorf("empty table returned error %v, want ErrSampleSize", err)
+	}
+}
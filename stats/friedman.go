@@ -0,0 +1,88 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+// A FriedmanResult is the result of a Friedman test.
+type FriedmanResult struct {
+	// N is the number of blocks and K is the number of
+	// treatments.
+	N, K int
+
+	// RankSums are the sums over all blocks of the within-block
+	// ranks of each treatment.
+	RankSums []float64
+
+	// Q is the Friedman chi-squared statistic, corrected for
+	// ties.
+	Q float64
+
+	// DoF is the degrees of freedom of the chi-squared
+	// distribution used to compute P. This is one less than the
+	// number of treatments.
+	DoF int
+
+	// P is the p-value of the test for the null hypothesis that
+	// all treatments have the same effect.
+	P float64
+}
+
+// FriedmanTest performs a Friedman test of the null hypothesis that
+// k treatments have identical effects, given measurements of the
+// treatments in each of several blocks. blocks[i][j] is the
+// measurement of treatment j in block i. For example, the treatments
+// may be algorithms and the blocks benchmark inputs.
+//
+// This is a non-parametric analog of repeated-measures ANOVA. The
+// measurements are ranked within each block, with tied values
+// assigned their average rank, so differences between blocks do not
+// affect the result. The p-value uses the chi-squared approximation
+// to the distribution of Q.
+//
+// This can fail with ErrSampleSize if there are no blocks or fewer
+// than two treatments, ErrMismatchedSamples if the blocks have
+// different lengths, or ErrSamplesEqual if every block's values are
+// all equal.
+func FriedmanTest(blocks [][]float64) (FriedmanResult, error) {
+	if len(blocks) == 0 {
+		return FriedmanResult{}, ErrSampleSize
+	}
+	k := len(blocks[0])
+	if k < 2 {
+		return FriedmanResult{}, ErrSampleSize
+	}
+	sums := make([]float64, k)
+	var ties float64
+	for _, b := range blocks {
+		if len(b) != k {
+			return FriedmanResult{}, ErrMismatchedSamples
+		}
+		ranks, t := ranksAndTies(b, TieAverage)
+		for j, r := range ranks {
+			sums[j] += r
+		}
+		ties += tieCorrection(t)
+	}
+
+	n, fk := float64(len(blocks)), float64(k)
+	den := n*fk*(fk+1) - ties/(fk-1)
+	if den == 0 {
+		return FriedmanResult{}, ErrSamplesEqual
+	}
+	mean := n * (fk + 1) / 2
+	q := 0.0
+	for _, r := range sums {
+		q += (r - mean) * (r - mean)
+	}
+	q *= 12 / den
+
+	return FriedmanResult{
+		N:        len(blocks),
+		K:        k,
+		RankSums: sums,
+		Q:        q,
+		DoF:      k - 1,
+		P:        1 - ChiSquaredDist{fk - 1}.CDF(q),
+	}, nil
+}
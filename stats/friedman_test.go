@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFriedmanTest(t *testing.T) {
+	// Rounding first base times from Hollander & Wolfe (1973),
+	// p. 140, as in R's friedman.test documentation, which gives
+	// Friedman chi-squared = 11.143, df = 2, p-value = 0.003805.
+	blocks := [][]float64{
+		{5.40, 5.50, 5.55},
+		{5.85, 5.70, 5.75},
+		{5.20, 5.60, 5.50},
+		{5.55, 5.50, 5.40},
+		{5.90, 5.85, 5.70},
+		{5.45, 5.55, 5.60},
+		{5.40, 5.40, 5.35},
+		{5.45, 5.50, 5.35},
+		{5.25, 5.15, 5.00},
+		{5.85, 5.80, 5.70},
+		{5.25, 5.20, 5.10},
+		{5.65, 5.55, 5.45},
+		{5.60, 5.35, 5.45},
+		{5.05, 5.00, 4.95},
+		{5.50, 5.50, 5.40},
+		{5.45, 5.55, 5.50},
+		{5.55, 5.55, 5.35},
+		{5.45, 5.50, 5.55},
+		{5.50, 5.45, 5.25},
+		{5.65, 5.60, 5.40},
+		{5.70, 5.65, 5.55},
+		{6.30, 6.30, 6.25},
+	}
+	res, err := FriedmanTest(blocks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.N != 22 || res.K != 3 || res.DoF != 2 {
+		t.Errorf("want N=22 K=3 DoF=2, got %+v", res)
+	}
+	for j, want := range []float64{53, 47, 32} {
+		if res.RankSums[j] != want {
+			t.Errorf("want RankSums [53 47 32], got %v", res.RankSums)
+			break
+		}
+	}
+	if !aeq(res.Q, 78.0/7) {
+		t.Errorf("want Q = %v, got %v", 78.0/7, res.Q)
+	}
+	if math.Abs(res.P-0.003805040775511363) > 1e-12 {
+		t.Errorf("want P = 0.003805, got %v", res.P)
+	}
+}
+
+func TestFriedmanTestErrors(t *testing.T) {
+	if _, err := FriedmanTest(nil); err != ErrSampleSize {
+		t.Errorf("no blocks: want ErrSampleSize, got %v", err)
+	}
+	if _, err := FriedmanTest([][]float64{{1}, {2}}); err != ErrSampleSize {
+		t.Errorf("one treatment: want ErrSampleSize, got %v", err)
+	}
+	if _, err := FriedmanTest([][]float64{{1, 2, 3}, {1, 2}}); err != ErrMismatchedSamples {
+		t.Errorf("ragged blocks: want ErrMismatchedSamples, got %v", err)
+	}
+	if _, err := FriedmanTest([][]float64{{1, 1}, {2, 2}}); err != ErrSamplesEqual {
+		t.Errorf("all tied: want ErrSamplesEqual, got %v", err)
+	}
+}
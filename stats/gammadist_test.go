@@ -0,0 +1,79 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestGammaDist(t *testing.T) {
+	d := GammaDist{Shape: 2, Scale: 3}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-1: 0,
+		0:  0,
+		3:  1 / (3 * math.E),
+		6:  2 / (3 * math.E * math.E),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-1: 0,
+		0:  0,
+		3:  1 - 2/math.E,
+		6:  1 - 3/(math.E*math.E),
+	})
+	testPDFIntegral(t, d, vec.Linspace(0, 20, 11))
+	testPDFIntegral(t, GammaDist{Shape: 7.5, Scale: 0.5}, vec.Linspace(0, 10, 11))
+
+	for _, d := range []GammaDist{{2, 3}, {0.5, 1}, {20, 0.1}} {
+		testFunc(t, fmt.Sprintf("%+v.InvCDF", d), d.InvCDF, map[float64]float64{
+			-0.1: nan,
+			0:    0,
+			1:    inf,
+			1.1:  nan,
+		})
+		for _, p := range []float64{0.001, 0.1, 0.5, 0.9, 0.999} {
+			if got := d.CDF(d.InvCDF(p)); !aeq(p, got) {
+				t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+			}
+		}
+	}
+}
+
+func TestGammaDistChiSquared(t *testing.T) {
+	// Tabulated upper critical values of the chi-squared
+	// distribution.
+	for _, c := range []struct{ k, p, x float64 }{
+		{1, 0.95, 3.841458820694124},
+		{2, 0.95, 5.991464547107979},
+		{10, 0.95, 18.307038053275146},
+		{10, 0.99, 23.209251158954356},
+	} {
+		d := GammaDist{Shape: c.k / 2, Scale: 2}
+		if got := d.CDF(c.x); math.Abs(got-c.p) > 1e-9 {
+			t.Errorf("chi-squared(%v).CDF(%v) = %v, want %v", c.k, c.x, got, c.p)
+		}
+	}
+}
+
+func TestGammaDistRand(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, d := range []GammaDist{{0.5, 2}, {1, 1}, {4, 0.5}} {
+		const n = 100000
+		xs := make([]float64, n)
+		for i := range xs {
+			xs[i] = d.Rand(r)
+		}
+		if m, want := Mean(xs), d.Mean(); math.Abs(m-want) > 0.02*want {
+			t.Errorf("%+v: sample mean %v, want %v", d, m, want)
+		}
+		if v, want := Variance(xs), d.Variance(); math.Abs(v-want) > 0.05*want {
+			t.Errorf("%+v: sample variance %v, want %v", d, v, want)
+		}
+	}
+}
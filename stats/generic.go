@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// Number is the set of integer and floating-point types accepted by
+// the generic statistics functions. It is equivalent to
+// constraints.Integer | constraints.Float from golang.org/x/exp.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// MeanG returns the arithmetic mean of xs. It is like Mean, but
+// accepts a slice of any integer or floating-point type. Each value
+// is converted to float64, so the result is identical to calling
+// Mean on the converted slice.
+func MeanG[T Number](xs []T) float64 {
+	if len(xs) == 0 {
+		return math.NaN()
+	}
+	m := 0.0
+	for i, x := range xs {
+		m += (float64(x) - m) / float64(i+1)
+	}
+	return m
+}
+
+// VarianceG returns the sample variance of xs. It is like Variance,
+// but accepts a slice of any integer or floating-point type.
+func VarianceG[T Number](xs []T) float64 {
+	if len(xs) == 0 {
+		return math.NaN()
+	} else if len(xs) <= 1 {
+		return 0
+	}
+
+	// Based on Wikipedia's presentation of Welford 1962
+	// (http://en.wikipedia.org/wiki/Algorithms_for_calculating_variance#Online_algorithm).
+	// This is more numerically stable than the standard two-pass
+	// formula and not prone to massive cancellation.
+	mean, M2 := 0.0, 0.0
+	for n, x := range xs {
+		x := float64(x)
+		delta := x - mean
+		mean += delta / float64(n+1)
+		M2 += delta * (x - mean)
+	}
+	return M2 / float64(len(xs)-1)
+}
+
+// StdDevG returns the sample standard deviation of xs. It is like
+// StdDev, but accepts a slice of any integer or floating-point type.
+func StdDevG[T Number](xs []T) float64 {
+	return math.Sqrt(VarianceG(xs))
+}
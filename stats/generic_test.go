@@ -0,0 +1,52 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGeneric(t *testing.T) {
+	ints := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	f32 := make([]float32, len(ints))
+	f64 := make([]float64, len(ints))
+	u8 := make([]uint8, len(ints))
+	for i, x := range ints {
+		f32[i], f64[i], u8[i] = float32(x), float64(x), uint8(x)
+	}
+	type myInt int
+	mine := []myInt{3, 1, 4, 1, 5, 9, 2, 6}
+
+	for _, c := range []struct {
+		name                   string
+		mean, variance, stddev float64
+	}{
+		{"[]int", MeanG(ints), VarianceG(ints), StdDevG(ints)},
+		{"[]float32", MeanG(f32), VarianceG(f32), StdDevG(f32)},
+		{"[]uint8", MeanG(u8), VarianceG(u8), StdDevG(u8)},
+		{"[]myInt", MeanG(mine), VarianceG(mine), StdDevG(mine)},
+		{"[]float64", MeanG(f64), VarianceG(f64), StdDevG(f64)},
+	} {
+		if c.mean != Mean(f64) || c.variance != Variance(f64) || c.stddev != StdDev(f64) {
+			t.Errorf("%s: mean, variance, stddev = %v, %v, %v, want %v, %v, %v", c.name, c.mean, c.variance, c.stddev, Mean(f64), Variance(f64), StdDev(f64))
+		}
+	}
+	if c := MeanG(ints); c != 3.875 {
+		t.Errorf("MeanG(%v) = %v, want 3.875", ints, c)
+	}
+
+	if !math.IsNaN(MeanG([]int{})) || !math.IsNaN(VarianceG([]int{})) {
+		t.Errorf("MeanG and VarianceG of empty slice should be NaN")
+	}
+	if v := VarianceG([]int{7}); v != 0 {
+		t.Errorf("VarianceG([7]) = %v, want 0", v)
+	}
+	// Large integers don't overflow.
+	big := []int64{math.MaxInt64, math.MaxInt64}
+	if m := MeanG(big); m != float64(math.MaxInt64) {
+		t.Errorf("MeanG(%v) = %v, want %v", big, m, float64(math.MaxInt64))
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// GeometricDist is a geometric distribution.
+//
+// This is the distribution of the number of failures before the first
+// success in a sequence of independent Bernoulli trials, each with
+// probability of success P. Hence, it has support {0, 1, 2, ...}.
+// (Some definitions instead count the number of trials up to and
+// including the first success. That distribution is this one shifted
+// by 1.)
+type GeometricDist struct {
+	// P is the probability of success in each trial. 0 < P <= 1.
+	P float64
+}
+
+// PMF is the probability of exactly int(k) failures before the first
+// success.
+func (d GeometricDist) PMF(k float64) float64 {
+	k = math.Floor(k)
+	if k < 0 {
+		return 0
+	} else if k == 0 {
+		// Avoid 0 * log(0) when P == 1.
+		return d.P
+	}
+	return math.Exp(k*math.Log1p(-d.P)) * d.P
+}
+
+// CDF is the probability of int(k) or fewer failures before the first
+// success.
+func (d GeometricDist) CDF(k float64) float64 {
+	k = math.Floor(k)
+	if k < 0 {
+		return 0
+	}
+	return -math.Expm1((k + 1) * math.Log1p(-d.P))
+}
+
+// Rand returns a random sample drawn from d. This takes the floor of
+// an exponential variate with rate -log(1-P).
+func (d GeometricDist) Rand(r *rand.Rand) float64 {
+	var e float64
+	if r == nil {
+		e = rand.ExpFloat64()
+	} else {
+		e = r.ExpFloat64()
+	}
+	return math.Floor(e / -math.Log1p(-d.P))
+}
+
+// discreteTail is the probability mass that Bounds leaves above the
+// upper bound for discrete distributions with infinite support.
+const discreteTail = 1e-9
+
+// Bounds returns 0 and the smallest k such that Pr[X > k] <= 1e-9.
+func (d GeometricDist) Bounds() (float64, float64) {
+	// Pr[X > k] = (1-P)^(k+1)
+	k := math.Ceil(math.Log(discreteTail)/math.Log1p(-d.P)) - 1
+	return 0, math.Max(k, 0)
+}
+
+func (d GeometricDist) Step() float64 {
+	return 1
+}
+
+func (d GeometricDist) Mean() float64 {
+	return (1 - d.P) / d.P
+}
+
+func (d GeometricDist) Variance() float64 {
+	return (1 - d.P) / (d.P * d.P)
+}
+
+func (d GeometricDist) Mode() float64 {
+	return 0
+}
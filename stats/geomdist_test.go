@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGeometricDist(t *testing.T) {
+	dist := GeometricDist{P: 0.25}
+	testFunc(t, fmt.Sprintf("%+v.PMF", dist), dist.PMF,
+		map[float64]float64{
+			-1:  0,
+			0:   0.25,
+			0.5: 0.25,
+			1:   0.1875,
+			2:   0.140625,
+		})
+	testDiscreteCDF(t, fmt.Sprintf("%+v.CDF", dist), dist)
+	testDiscreteCDF(t, "GeometricDist{P: 1}.CDF", GeometricDist{P: 1})
+	testFunc(t, "GeometricDist{P: 1}.PMF", GeometricDist{P: 1}.PMF,
+		map[float64]float64{-1: 0, 0: 1, 1: 0, 2: 0})
+
+	if _, h := dist.Bounds(); 1-dist.CDF(h) > 1e-9 || 1-dist.CDF(h-1) <= 1e-9 {
+		t.Errorf("%+v.Bounds() upper bound %v is not the 1e-9 tail", dist, h)
+	}
+	if m := dist.Mean(); m != 3 {
+		t.Errorf("%+v.Mean() = %v, want 3", dist, m)
+	}
+	if v := dist.Variance(); v != 12 {
+		t.Errorf("%+v.Variance() = %v, want 12", dist, v)
+	}
+}
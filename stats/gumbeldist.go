@@ -0,0 +1,78 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// GumbelDist is a Gumbel (type I generalized extreme value)
+// distribution with location Mu and scale Beta.
+//
+// The Gumbel distribution is the limiting distribution of the maximum
+// of many samples drawn from a distribution with exponential tails,
+// such as the normal distribution.
+type GumbelDist struct {
+	// Mu is the location parameter. This is the mode of the
+	// distribution.
+	Mu float64
+
+	// Beta is the scale parameter. Beta > 0.
+	Beta float64
+}
+
+// Euler-Mascheroni constant γ
+const eulerGamma = 0.57721566490153286060651209008240243104215933593992
+
+func (d GumbelDist) PDF(x float64) float64 {
+	z := (x - d.Mu) / d.Beta
+	return math.Exp(-(z + math.Exp(-z))) / d.Beta
+}
+
+func (d GumbelDist) CDF(x float64) float64 {
+	return math.Exp(-math.Exp(-(x - d.Mu) / d.Beta))
+}
+
+func (d GumbelDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 {
+		return nan
+	} else if p == 0 {
+		return -inf
+	} else if p == 1 {
+		return inf
+	}
+	return d.Mu - d.Beta*math.Log(-math.Log(p))
+}
+
+// Rand returns a random sample drawn from d using inverse transform
+// sampling.
+func (d GumbelDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	for u == 0 {
+		if r == nil {
+			u = rand.Float64()
+		} else {
+			u = r.Float64()
+		}
+	}
+	return d.InvCDF(u)
+}
+
+func (d GumbelDist) Bounds() (float64, float64) {
+	return d.InvCDF(0.001), d.InvCDF(0.999)
+}
+
+func (d GumbelDist) Mean() float64 {
+	return d.Mu + d.Beta*eulerGamma
+}
+
+func (d GumbelDist) Variance() float64 {
+	return math.Pi * math.Pi / 6 * d.Beta * d.Beta
+}
+
+func (d GumbelDist) Median() float64 {
+	return d.Mu - d.Beta*math.Log(math.Ln2)
+}
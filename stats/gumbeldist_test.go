@@ -0,0 +1,55 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestGumbelDist(t *testing.T) {
+	d := GumbelDist{Mu: 1, Beta: 2}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		1: math.Exp(-1) / 2,
+		3: math.Exp(-1-math.Exp(-1)) / 2,
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-inf: 0,
+		1:    math.Exp(-1),
+		3:    math.Exp(-math.Exp(-1)),
+		inf:  1,
+	})
+	testInvCDF(t, d, false)
+	testPDFIntegral(t, d, vec.Linspace(-4, 16, 11))
+	for _, p := range vec.Linspace(0, 1, 21) {
+		if got := d.CDF(d.InvCDF(p)); !aeq(p, got) {
+			t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+		}
+	}
+}
+
+func TestGumbelDistMoments(t *testing.T) {
+	// The maximum of n standard exponential samples approaches
+	// Gumbel(log n, 1).
+	r := rand.New(rand.NewSource(1))
+	const n = 1000
+	maxes := make([]float64, 10000)
+	for i := range maxes {
+		for j := 0; j < n; j++ {
+			maxes[i] = math.Max(maxes[i], r.ExpFloat64())
+		}
+	}
+	d := GumbelDist{Mu: math.Log(n), Beta: 1}
+	if m, want := Mean(maxes), d.Mean(); math.Abs(m-want) > 0.05 {
+		t.Errorf("mean of maxima = %v, want %v", m, want)
+	}
+	if v, want := Variance(maxes), d.Variance(); math.Abs(v-want) > 0.1 {
+		t.Errorf("variance of maxima = %v, want %v", v, want)
+	}
+}
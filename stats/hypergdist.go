@@ -6,12 +6,17 @@ package stats
 
 import (
 	"math"
+	"math/rand"
 
 	"github.com/jgbaldwinbrown/go-moremath/mathx"
 )
 
-// HypergeometicDist is a hypergeometric distribution.
-type HypergeometicDist struct {
+// HypergeometricDist is a hypergeometric distribution.
+//
+// This is the distribution of the number of successes in Draws draws
+// without replacement from a population of size N that contains
+// exactly K successes.
+type HypergeometricDist struct {
 	// N is the size of the population. N >= 0.
 	N int
 
@@ -24,10 +29,15 @@ type HypergeometicDist struct {
 	Draws int
 }
 
+// HypergeometicDist is a misspelling of HypergeometricDist.
+//
+// Deprecated: Use HypergeometricDist.
+type HypergeometicDist = HypergeometricDist
+
 // PMF is the probability of getting exactly int(k) successes in
-// d.Draws draws with replacement from a population of size d.N that
-// contains exactly d.K successes.
-func (d HypergeometicDist) PMF(k float64) float64 {
+// d.Draws draws without replacement from a population of size d.N
+// that contains exactly d.K successes.
+func (d HypergeometricDist) PMF(k float64) float64 {
 	ki := int(math.Floor(k))
 	l, h := d.bounds()
 	if ki < l || ki > h {
@@ -36,14 +46,14 @@ func (d HypergeometicDist) PMF(k float64) float64 {
 	return d.pmf(ki)
 }
 
-func (d HypergeometicDist) pmf(k int) float64 {
+func (d HypergeometricDist) pmf(k int) float64 {
 	return math.Exp(mathx.Lchoose(d.K, k) + mathx.Lchoose(d.N-d.K, d.Draws-k) - mathx.Lchoose(d.N, d.Draws))
 }
 
 // CDF is the probability of getting int(k) or fewer successes in
-// d.Draws draws with replacement from a population of size d.N that
-// contains exactly d.K successes.
-func (d HypergeometicDist) CDF(k float64) float64 {
+// d.Draws draws without replacement from a population of size d.N
+// that contains exactly d.K successes.
+func (d HypergeometricDist) CDF(k float64) float64 {
 	// Based on Klotz, A Computational Approach to Statistics.
 	ki := int(math.Floor(k))
 	l, h := d.bounds()
@@ -52,9 +62,12 @@ func (d HypergeometicDist) CDF(k float64) float64 {
 	} else if ki >= h {
 		return 1
 	}
-	// Use symmetry to compute the smaller sum.
+	// Use symmetry to compute the smaller sum. The terms of the
+	// sum decrease away from the mode, so sum over the side of
+	// the mode that ki is on.
 	flip := false
-	if ki > (d.Draws+1)/(d.N+1)*(d.K+1) {
+	mode := float64(d.Draws+1) * float64(d.K+1) / float64(d.N+1)
+	if float64(ki) > mode {
 		flip = true
 		ki = d.K - ki - 1
 		d.Draws = d.N - d.Draws
@@ -66,7 +79,7 @@ func (d HypergeometicDist) CDF(k float64) float64 {
 	return p
 }
 
-func (d HypergeometicDist) sum(k int) float64 {
+func (d HypergeometricDist) sum(k int) float64 {
 	const epsilon = 1e-14
 	sum, ak := 1.0, 1.0
 	L := maxint(0, d.Draws+d.K-d.N)
@@ -78,24 +91,43 @@ func (d HypergeometicDist) sum(k int) float64 {
 	return sum
 }
 
-func (d HypergeometicDist) bounds() (int, int) {
+// Rand returns a random sample drawn from d by inverting the CDF.
+func (d HypergeometricDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	if r == nil {
+		u = rand.Float64()
+	} else {
+		u = r.Float64()
+	}
+	l, h := d.bounds()
+	return float64(invDiscreteCDF(d.CDF, u, l, h))
+}
+
+func (d HypergeometricDist) bounds() (int, int) {
 	return maxint(0, d.Draws+d.K-d.N), minint(d.Draws, d.K)
 }
 
-func (d HypergeometicDist) Bounds() (float64, float64) {
+func (d HypergeometricDist) Bounds() (float64, float64) {
 	l, h := d.bounds()
 	return float64(l), float64(h)
 }
 
-func (d HypergeometicDist) Step() float64 {
+func (d HypergeometricDist) Step() float64 {
 	return 1
 }
 
-func (d HypergeometicDist) Mean() float64 {
-	return float64(d.Draws*d.K) / float64(d.N)
+func (d HypergeometricDist) Mean() float64 {
+	return float64(d.Draws) * float64(d.K) / float64(d.N)
+}
+
+func (d HypergeometricDist) Variance() float64 {
+	// Compute in floating point to avoid overflow for large N.
+	n, k, draws := float64(d.N), float64(d.K), float64(d.Draws)
+	return draws * k * (n - k) * (n - draws) / (n * n * (n - 1))
 }
 
-func (d HypergeometicDist) Variance() float64 {
-	return float64(d.Draws*d.K*(d.N-d.K)*(d.N-d.Draws)) /
-		float64(d.N*d.N*(d.N-1))
+// Mode returns the mode of d. If (Draws+1)(K+1)/(N+2) is an integer,
+// both it and one less are modes and Mode returns the larger.
+func (d HypergeometricDist) Mode() float64 {
+	return math.Floor(float64(d.Draws+1) * float64(d.K+1) / float64(d.N+2))
 }
@@ -6,11 +6,12 @@ package stats
 
 import (
 	"fmt"
+	"math"
 	"testing"
 )
 
 func TestHypergeometricDist(t *testing.T) {
-	dist1 := HypergeometicDist{N: 50, K: 5, Draws: 10}
+	dist1 := HypergeometricDist{N: 50, K: 5, Draws: 10}
 	testFunc(t, fmt.Sprintf("%+v.PMF", dist1), dist1.PMF,
 		map[float64]float64{
 			-0.1: 0,
@@ -22,3 +23,32 @@ func TestHypergeometricDist(t *testing.T) {
 		})
 	testDiscreteCDF(t, fmt.Sprintf("%+v.CDF", dist1), dist1)
 }
+
+func TestHypergeometricDistLarge(t *testing.T) {
+	for _, dist := range []HypergeometricDist{
+		{N: 50000, K: 20000, Draws: 3000},
+		{N: 30000, K: 100, Draws: 20000},
+		{N: 1000, K: 3, Draws: 10},
+	} {
+		// The PMF should sum to 1 over the support and agree
+		// with the CDF.
+		l, h := dist.Bounds()
+		sum := 0.0
+		for k := l; k <= h; k++ {
+			sum += dist.PMF(k)
+			if cdf := dist.CDF(k); math.Abs(cdf-sum) > 1e-9 {
+				t.Errorf("%+v.CDF(%v) = %v, but PMF sums to %v", dist, k, cdf, sum)
+				break
+			}
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("%+v.PMF sums to %v over [%v, %v]", dist, sum, l, h)
+		}
+		if p := dist.PMF(l - 1); p != 0 {
+			t.Errorf("%+v.PMF(%v) = %v, want 0", dist, l-1, p)
+		}
+		if p := dist.PMF(h + 1); p != 0 {
+			t.Errorf("%+v.PMF(%v) = %v, want 0", dist, h+1, p)
+		}
+	}
+}
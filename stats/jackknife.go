@@ -0,0 +1,48 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// Jackknife estimates the bias and standard error of statistic on xs
+// using the leave-one-out jackknife. Let θ̂ be statistic(xs), θ_i be
+// statistic applied to xs with the i'th value removed, and θ̄ be the
+// mean of the θ_i. Then
+//
+//	bias     = (n-1)(θ̄ - θ̂)
+//	stderr   = √((n-1)/n Σ(θ_i - θ̄)²)
+//	estimate = θ̂ - bias
+//
+// so estimate is the bias-corrected jackknife estimate. Unlike
+// Bootstrap, this is deterministic and requires exactly n
+// evaluations of statistic.
+//
+// statistic may modify its argument. If len(xs) < 2, Jackknife
+// returns NaN for all results.
+func Jackknife(xs []float64, statistic func([]float64) float64) (estimate, bias, stderr float64) {
+	n := len(xs)
+	if n < 2 {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+	full := statistic(append([]float64(nil), xs...))
+
+	loo := make([]float64, n)
+	sub := make([]float64, n-1)
+	for i := range xs {
+		copy(sub, xs[:i])
+		copy(sub[i:], xs[i+1:])
+		loo[i] = statistic(sub)
+	}
+
+	fn := float64(n)
+	mean := Mean(loo)
+	ss := 0.0
+	for _, θ := range loo {
+		ss += (θ - mean) * (θ - mean)
+	}
+	bias = (fn - 1) * (mean - full)
+	stderr = math.Sqrt((fn - 1) / fn * ss)
+	return full - bias, bias, stderr
+}
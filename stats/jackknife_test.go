@@ -0,0 +1,59 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJackknife(t *testing.T) {
+	xs := []float64{2, 4, 4, 5, 7, 9, 12, 13}
+
+	// For the mean, the jackknife is exact: there is no bias and
+	// the standard error is the usual s/√n.
+	est, bias, se := Jackknife(xs, Mean)
+	if !aeq(est, Mean(xs)) {
+		t.Errorf("Jackknife(Mean) estimate = %v, want %v", est, Mean(xs))
+	}
+	if math.Abs(bias) > 1e-12 {
+		t.Errorf("Jackknife(Mean) bias = %v, want 0", bias)
+	}
+	if want := StdDev(xs) / math.Sqrt(float64(len(xs))); !aeq(se, want) {
+		t.Errorf("Jackknife(Mean) stderr = %v, want %v", se, want)
+	}
+
+	// The jackknife corrects the bias of the plug-in variance
+	// exactly, giving the unbiased sample variance.
+	plugin := func(xs []float64) float64 {
+		m2, _, _ := centralMoments(xs)
+		return m2
+	}
+	est, bias, _ = Jackknife(xs, plugin)
+	if !aeq(est, Variance(xs)) {
+		t.Errorf("Jackknife(plug-in variance) estimate = %v, want %v", est, Variance(xs))
+	}
+	if want := plugin(xs) - Variance(xs); !aeq(bias, want) {
+		t.Errorf("Jackknife(plug-in variance) bias = %v, want %v", bias, want)
+	}
+
+	// statistic may modify its argument without affecting xs.
+	orig := append([]float64(nil), xs...)
+	Jackknife(xs, func(xs []float64) float64 {
+		for i := range xs {
+			xs[i] = 0
+		}
+		return 0
+	})
+	for i := range xs {
+		if xs[i] != orig[i] {
+			t.Fatalf("Jackknife modified its input")
+		}
+	}
+
+	if est, bias, se := Jackknife([]float64{1}, Mean); !math.IsNaN(est) || !math.IsNaN(bias) || !math.IsNaN(se) {
+		t.Errorf("Jackknife of one value = %v, %v, %v, want NaN", est, bias, se)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// A JBTestResult is the result of a Jarque-Bera test.
+type JBTestResult struct {
+	// N is the size of the input sample.
+	N int
+
+	// JB is the Jarque-Bera statistic, n/6 (S² + K²/4), where S
+	// is the sample skewness and K is the sample excess
+	// kurtosis.
+	JB float64
+
+	// P is the p-value of the test for the null hypothesis that
+	// the sample is drawn from a normal distribution.
+	P float64
+}
+
+// JarqueBeraTest performs a Jarque-Bera test of the null hypothesis
+// that sample is drawn from a normal distribution, based on the
+// sample skewness and excess kurtosis. The p-value uses the
+// asymptotic chi-squared distribution with 2 degrees of freedom,
+// which is only accurate for large samples.
+//
+// This can fail with ErrSampleSize if sample has fewer than 2 values
+// or ErrZeroVariance if all sample values are equal.
+func JarqueBeraTest(sample []float64) (*JBTestResult, error) {
+	n := len(sample)
+	if n < 2 {
+		return nil, ErrSampleSize
+	}
+	m2, m3, m4 := centralMoments(sample)
+	if m2 == 0 {
+		return nil, ErrZeroVariance
+	}
+	// The Jarque-Bera statistic uses the uncorrected moment
+	// estimators, not the bias-corrected Skewness and Kurtosis.
+	fn := float64(n)
+	s := m3 / (m2 * math.Sqrt(m2))
+	k := m4/(m2*m2) - 3
+
+	jb := fn / 6 * (s*s + k*k/4)
+	p := 1 - ChiSquaredDist{2}.CDF(jb)
+	return &JBTestResult{N: n, JB: jb, P: p}, nil
+}
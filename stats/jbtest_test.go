@@ -0,0 +1,56 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestJarqueBeraTest(t *testing.T) {
+	// Compute the population central moments directly.
+	xs := []float64{1, 2, 3, 4, 8}
+	mean := 3.6
+	var m2, m3, m4 float64
+	for _, x := range xs {
+		d := x - mean
+		m2 += d * d / 5
+		m3 += d * d * d / 5
+		m4 += d * d * d * d / 5
+	}
+	s, k := m3/math.Pow(m2, 1.5), m4/(m2*m2)-3
+	wantJB := 5.0 / 6 * (s*s + k*k/4)
+	res, err := JarqueBeraTest(xs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.N != 5 || !aeq(res.JB, wantJB) || !aeq(res.P, math.Exp(-wantJB/2)) {
+		t.Errorf("JarqueBeraTest(%v) = %+v, want JB=%v", xs, res, wantJB)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	res, err = JarqueBeraTest(SampleN(NormalDist{3, 2}, 5000, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("normal sample: P = %v, want large", res.P)
+	}
+	res, err = JarqueBeraTest(SampleN(LogNormalDist{0, 1}, 5000, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P > 1e-6 {
+		t.Errorf("log-normal sample: P = %v, want tiny", res.P)
+	}
+
+	if _, err := JarqueBeraTest([]float64{1}); err != ErrSampleSize {
+		t.Errorf("JarqueBeraTest of one value returned error %v, want ErrSampleSize", err)
+	}
+	if _, err := JarqueBeraTest([]float64{2, 2, 2}); err != ErrZeroVariance {
+		t.Errorf("JarqueBeraTest of constant sample returned error %v, want ErrZeroVariance", err)
+	}
+}
@@ -6,7 +6,11 @@ package stats
 
 import (
 	"fmt"
+	"math"
+	"math/rand"
 	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
 )
 
 func TestKDEOneSample(t *testing.T) {
@@ -87,3 +91,84 @@ func TestKDETwoSamples(t *testing.T) {
 		3: 0.670672373,
 		4: 0.812327630})
 }
+
+func TestKDEIntegral(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	xs := append(SampleN(NormalDist{Mu: -2, Sigma: 1}, 100, r),
+		SampleN(NormalDist{Mu: 3, Sigma: 0.5}, 100, r)...)
+	for _, kernel := range []KDEKernel{GaussianKernel, EpanechnikovKernel} {
+		kde := &KDE{Sample: Sample{Xs: xs}, Kernel: kernel}
+		// Bounds only covers most of the mass, so integrate
+		// well beyond the data.
+		lo, hi := Bounds(xs)
+		lo, hi = lo-10, hi+10
+		if got := simpson(kde.PDF, lo, hi, 20000); math.Abs(got-1) > 1e-7 {
+			t.Errorf("%v KDE: ∫PDF over [%v, %v] = %v, want 1", kernel, lo, hi, got)
+		}
+		// The PDF should also agree with the CDF.
+		mid := (lo + hi) / 2
+		got := simpson(kde.PDF, lo, mid, 20000)
+		if want := kde.CDF(mid); math.Abs(got-want) > 1e-7 {
+			t.Errorf("%v KDE: ∫PDF over [%v, %v] = %v, but CDF = %v", kernel, lo, mid, got, want)
+		}
+	}
+
+	// With a bounded support, reflection keeps all of the mass
+	// inside the bounds.
+	kde := &KDE{
+		Sample:      Sample{Xs: SampleN(ExponentialDist{Rate: 1}, 100, r)},
+		Kernel:      GaussianKernel,
+		BoundaryMin: 0,
+		BoundaryMax: inf,
+	}
+	_, hi := kde.Sample.Bounds()
+	if got := simpson(kde.PDF, 0, hi+10, 20000); math.Abs(got-1) > 1e-7 {
+		t.Errorf("reflected KDE: ∫PDF over [0, %v] = %v, want 1", hi+10, got)
+	}
+}
+
+func TestKDEKernelString(t *testing.T) {
+	for k, want := range map[KDEKernel]string{
+		EpanechnikovKernel: "EpanechnikovKernel",
+		GaussianKernel:     "GaussianKernel",
+		DeltaKernel:        "DeltaKernel",
+		DeltaKernel + 1:    "KDEKernel(3)",
+	} {
+		if got := k.String(); got != want {
+			t.Errorf("KDEKernel(%d).String() = %q, want %q", int(k), got, want)
+		}
+	}
+}
+
+func TestKDEBandwidthSmoothing(t *testing.T) {
+	// The total variation of the PDF, Σ|f(x_{i+1}) - f(x_i)|,
+	// measures how wiggly the estimate is. It should decrease as
+	// the bandwidth increases.
+	xs := []float64{-3, -2.5, -1, 0, 0.2, 0.4, 2, 4, 4.1}
+	grid := vec.Linspace(-10, 14, 2000)
+	prev := inf
+	for _, bw := range []float64{0.1, 0.3, 1, 3, 10} {
+		kde := &KDE{Sample: Sample{Xs: xs}, Kernel: GaussianKernel, Bandwidth: bw}
+		ys := vec.Map(kde.PDF, grid)
+		tv := 0.0
+		for i := 1; i < len(ys); i++ {
+			tv += math.Abs(ys[i] - ys[i-1])
+		}
+		if !(tv < prev) {
+			t.Errorf("bandwidth %v: total variation %v, want < %v", bw, tv, prev)
+		}
+		prev = tv
+	}
+}
+
+func TestBandwidthSilverman(t *testing.T) {
+	s := Sample{Xs: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}}
+	want := 1.06 * s.StdDev() * math.Pow(10, -0.2)
+	if got := BandwidthSilverman(s); !aeq(got, want) {
+		t.Errorf("BandwidthSilverman(%v) = %v, want %v", s.Xs, got, want)
+	}
+	// This is the default when the sample has no outliers.
+	if got := BandwidthScott(s); !aeq(got, want) {
+		t.Errorf("BandwidthScott(%v) = %v, want Silverman bandwidth %v", s.Xs, got, want)
+	}
+}
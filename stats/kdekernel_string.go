@@ -4,9 +4,9 @@ package stats
 
 import "fmt"
 
-const _KDEKernel_name = "GaussianKernelDeltaKernel"
+const _KDEKernel_name = "EpanechnikovKernelGaussianKernelDeltaKernel"
 
-var _KDEKernel_index = [...]uint8{0, 14, 25}
+var _KDEKernel_index = [...]uint8{0, 18, 32, 43}
 
 func (i KDEKernel) String() string {
 	if i < 0 || i+1 >= KDEKernel(len(_KDEKernel_index)) {
@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// KLDivergence returns the Kullback-Leibler divergence D(p || q) of
+// continuous distribution q from continuous distribution p in nats,
+// computed by numerically integrating
+//
+//	p.PDF(x) * log(p.PDF(x) / q.PDF(x))
+//
+// over [lo, hi] using adaptive Simpson's rule. Points where p.PDF(x)
+// is 0 contribute nothing to the integral. If q.PDF(x) is 0 at a
+// point where p.PDF(x) is not, the result is +Inf.
+//
+// lo and hi will typically be p.Bounds(), but may need to be wider if
+// p or q has heavy tails.
+func KLDivergence(p, q Dist, lo, hi float64) float64 {
+	const tolerance = 1e-10
+	return integrateAdaptive(func(x float64) float64 {
+		px := p.PDF(x)
+		if px <= 0 {
+			return 0
+		}
+		qx := q.PDF(x)
+		if qx <= 0 {
+			// This stops the integration.
+			return inf
+		}
+		return px * math.Log(px/qx)
+	}, lo, hi, tolerance)
+}
+
+// DiscreteKLDivergence returns the Kullback-Leibler divergence
+// D(p || q) of discrete distribution q from discrete distribution p
+// in nats. This is the sum of
+//
+//	p.PMF(x) * log(p.PMF(x) / q.PMF(x))
+//
+// over the union of p.Bounds() and q.Bounds(). p and q must have the
+// same Step. Points where p.PMF(x) is 0 contribute nothing to the sum.
+// If q.PMF(x) is 0 at a point where p.PMF(x) is not, the result is
+// +Inf.
+func DiscreteKLDivergence(p, q DiscreteDist) float64 {
+	lp, hp := p.Bounds()
+	lq, hq := q.Bounds()
+	l, h := math.Min(lp, lq), math.Max(hp, hq)
+	s := p.Step()
+	var sum float64
+	for x := l; x <= h; x += s {
+		px := p.PMF(x)
+		if px <= 0 {
+			continue
+		}
+		qx := q.PMF(x)
+		if qx <= 0 {
+			return inf
+		}
+		sum += px * math.Log(px/qx)
+	}
+	return sum
+}
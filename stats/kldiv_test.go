@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKLDivergence(t *testing.T) {
+	for _, d := range []Dist{StdNormal, NormalDist{3, 0.2}, ExponentialDist{2}, UniformDist{-1, 4}} {
+		lo, hi := d.Bounds()
+		if got := KLDivergence(d, d, lo, hi); math.Abs(got) > 1e-12 {
+			t.Errorf("KLDivergence(%+v, %+v) = %v, want 0", d, d, got)
+		}
+	}
+
+	// D(N(μ1, σ1) || N(μ2, σ2)) =
+	//   log(σ2/σ1) + (σ1² + (μ1-μ2)²) / (2 σ2²) - 1/2
+	for _, c := range [][2]NormalDist{
+		{StdNormal, {1, 1}},
+		{StdNormal, {0, 2}},
+		{{1, 0.5}, {-1, 3}},
+	} {
+		p, q := c[0], c[1]
+		want := math.Log(q.Sigma/p.Sigma) +
+			(p.Sigma*p.Sigma+(p.Mu-q.Mu)*(p.Mu-q.Mu))/(2*q.Sigma*q.Sigma) - 0.5
+		got := KLDivergence(p, q, p.Mu-20*p.Sigma, p.Mu+20*p.Sigma)
+		if math.Abs(got-want) > 1e-8 {
+			t.Errorf("KLDivergence(%+v, %+v) = %v, want %v", p, q, got, want)
+		}
+	}
+
+	// D(Exp(λ1) || Exp(λ2)) = log(λ1/λ2) + λ2/λ1 - 1
+	p, q := ExponentialDist{1}, ExponentialDist{3}
+	want := math.Log(1.0/3) + 3 - 1
+	if got := KLDivergence(p, q, 0, 60); math.Abs(got-want) > 1e-8 {
+		t.Errorf("KLDivergence(%+v, %+v) = %v, want %v", p, q, got, want)
+	}
+
+	// q has no mass where p does.
+	if got := KLDivergence(UniformDist{0, 2}, UniformDist{0, 1}, 0, 2); !math.IsInf(got, 1) {
+		t.Errorf("KLDivergence with disjoint support = %v, want +Inf", got)
+	}
+}
+
+func TestDiscreteKLDivergence(t *testing.T) {
+	for _, d := range []DiscreteDist{BinomialDist{10, 0.3}, BernoulliDist{0.1}, GeometricDist{0.4}} {
+		if got := DiscreteKLDivergence(d, d); math.Abs(got) > 1e-12 {
+			t.Errorf("DiscreteKLDivergence(%+v, %+v) = %v, want 0", d, d, got)
+		}
+	}
+
+	// D(Bern(a) || Bern(b)) = a log(a/b) + (1-a) log((1-a)/(1-b))
+	a, b := 0.3, 0.6
+	want := a*math.Log(a/b) + (1-a)*math.Log((1-a)/(1-b))
+	if got := DiscreteKLDivergence(BernoulliDist{a}, BernoulliDist{b}); !aeq(want, got) {
+		t.Errorf("DiscreteKLDivergence(Bern(%v), Bern(%v)) = %v, want %v", a, b, got, want)
+	}
+
+	// Binomial KL divergence is N times the Bernoulli divergence.
+	got := DiscreteKLDivergence(BinomialDist{20, a}, BinomialDist{20, b})
+	if !aeq(20*want, got) {
+		t.Errorf("DiscreteKLDivergence(Binom(20, %v), Binom(20, %v)) = %v, want %v", a, b, got, 20*want)
+	}
+
+	if got := DiscreteKLDivergence(BinomialDist{5, 0.5}, BinomialDist{3, 0.5}); !math.IsInf(got, 1) {
+		t.Errorf("DiscreteKLDivergence with disjoint support = %v, want +Inf", got)
+	}
+}
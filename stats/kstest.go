@@ -0,0 +1,145 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// A KSTestResult is the result of a Kolmogorov-Smirnov test.
+type KSTestResult struct {
+	// N1 and N2 are the sizes of the input samples. For a
+	// one-sample test, N2 is 0.
+	N1, N2 int
+
+	// D is the Kolmogorov-Smirnov statistic: the largest absolute
+	// difference between the compared CDFs.
+	D float64
+
+	// P is the p-value of the test for the null hypothesis that
+	// the samples are drawn from the same distribution.
+	P float64
+}
+
+// KolmogorovSmirnovTest performs a one-sample Kolmogorov-Smirnov
+// goodness-of-fit test of the null hypothesis that sample is drawn
+// from dist. The statistic D is the largest absolute difference
+// between the empirical CDF of sample and dist.CDF. Tied values in
+// sample are handled by comparing dist.CDF against the empirical CDF
+// both before and after the jump at each distinct value.
+//
+// The p-value is computed from the asymptotic Kolmogorov
+// distribution using Stephens' (1970) correction for finite sample
+// sizes. This assumes dist is continuous; for discrete distributions
+// the test is conservative.
+//
+// sample need not be sorted. KolmogorovSmirnovTest sorts a copy of
+// sample if it is not already sorted.
+//
+// This can fail with ErrSampleSize if sample is empty.
+func KolmogorovSmirnovTest(sample []float64, dist DistCommon) (*KSTestResult, error) {
+	n := len(sample)
+	if n == 0 {
+		return nil, ErrSampleSize
+	}
+	if !sort.Float64sAreSorted(sample) {
+		sample = append([]float64(nil), sample...)
+		sort.Float64s(sample)
+	}
+
+	fn := float64(n)
+	d := 0.0
+	for i := 0; i < n; {
+		// Consume all samples that tie sample[i]. The
+		// empirical CDF steps from i/n to j/n at x.
+		x, j := sample[i], i+1
+		for j < n && sample[j] == x {
+			j++
+		}
+		cdf := dist.CDF(x)
+		d = math.Max(d, math.Max(float64(j)/fn-cdf, cdf-float64(i)/fn))
+		i = j
+	}
+
+	return &KSTestResult{N1: n, D: d, P: ksPValue(d, fn)}, nil
+}
+
+// ksPValue returns the p-value of Kolmogorov-Smirnov statistic d for
+// effective sample size n using Stephens' approximation.
+func ksPValue(d, n float64) float64 {
+	sn := math.Sqrt(n)
+	return kolmogorovQ((sn + 0.12 + 0.11/sn) * d)
+}
+
+// kolmogorovQ returns the complementary CDF of the Kolmogorov
+// distribution, Pr[K > λ].
+func kolmogorovQ(λ float64) float64 {
+	if λ <= 0 {
+		return 1
+	} else if λ < 1.18 {
+		// Use the form that converges quickly for small λ:
+		// Pr[K <= λ] = √(2π)/λ Σ exp(-(2k-1)²π²/(8λ²)).
+		y := -math.Pi * math.Pi / (8 * λ * λ)
+		sum := 0.0
+		for k := 1; k <= 10; k++ {
+			t := math.Exp(float64((2*k-1)*(2*k-1)) * y)
+			sum += t
+			if t < 1e-17*sum {
+				break
+			}
+		}
+		return 1 - math.Sqrt(2*math.Pi)/λ*sum
+	}
+	// Pr[K > λ] = 2 Σ (-1)^(k-1) exp(-2k²λ²).
+	sum, sign := 0.0, 1.0
+	for k := 1; k <= 100; k++ {
+		t := math.Exp(-2 * float64(k*k) * λ * λ)
+		sum += sign * t
+		sign = -sign
+		if t < 1e-17*sum {
+			break
+		}
+	}
+	return math.Max(0, math.Min(1, 2*sum))
+}
+
+// TwoSampleKSTest performs a two-sample Kolmogorov-Smirnov test of
+// the null hypothesis that samples a and b are drawn from the same
+// continuous distribution. The statistic D is the largest absolute
+// difference between the empirical CDFs of a and b.
+//
+// The p-value is computed from the asymptotic Kolmogorov
+// distribution with effective sample size n*m/(n+m), using Stephens'
+// correction as in KolmogorovSmirnovTest.
+//
+// This can fail with ErrSampleSize if either sample is empty.
+func TwoSampleKSTest(a, b []float64) (*KSTestResult, error) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return nil, ErrSampleSize
+	}
+	a = append([]float64(nil), a...)
+	b = append([]float64(nil), b...)
+	sort.Float64s(a)
+	sort.Float64s(b)
+
+	// Walk the merged order of a and b, stepping both empirical
+	// CDFs past all values equal to the smallest remaining value.
+	fn, fm := float64(n), float64(m)
+	d := 0.0
+	for i, j := 0, 0; i < n && j < m; {
+		x := math.Min(a[i], b[j])
+		for i < n && a[i] == x {
+			i++
+		}
+		for j < m && b[j] == x {
+			j++
+		}
+		d = math.Max(d, math.Abs(float64(i)/fn-float64(j)/fm))
+	}
+
+	return &KSTestResult{N1: n, N2: m, D: d, P: ksPValue(d, fn*fm/(fn+fm))}, nil
+}
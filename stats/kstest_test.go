@@ -0,0 +1,103 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestKolmogorovQ(t *testing.T) {
+	testFunc(t, "kolmogorovQ", kolmogorovQ, map[float64]float64{
+		0:    1,
+		0.3:  0.9999906941986655,
+		0.5:  0.9639452436648751,
+		1.0:  0.2699996716773546,
+		1.17: 0.129390042185619,
+		1.19: 0.11774229287977167,
+		1.36: 0.049485876755377876,
+		2.0:  0.0006709252557796953,
+	})
+}
+
+func TestKolmogorovSmirnovTest(t *testing.T) {
+	check := func(sample []float64, dist DistCommon, wantD float64) {
+		t.Helper()
+		res, err := KolmogorovSmirnovTest(sample, dist)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.N1 != len(sample) || res.N2 != 0 {
+			t.Errorf("KolmogorovSmirnovTest(%v): N1, N2 = %d, %d, want %d, 0", sample, res.N1, res.N2, len(sample))
+		}
+		if !aeq(wantD, res.D) {
+			t.Errorf("KolmogorovSmirnovTest(%v): D = %v, want %v", sample, res.D, wantD)
+		}
+	}
+	u := UniformDist{0, 1}
+	check([]float64{0.1, 0.4, 0.7}, u, 0.3)
+	check([]float64{0.7, 0.1, 0.4}, u, 0.3)
+	// With ties, the empirical CDF jumps by 2/3 at 0.2.
+	check([]float64{0.2, 0.9, 0.2}, u, 2.0/3-0.2)
+
+	if _, err := KolmogorovSmirnovTest(nil, u); err != ErrSampleSize {
+		t.Errorf("KolmogorovSmirnovTest(nil) returned error %v, want ErrSampleSize", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	res, err := KolmogorovSmirnovTest(SampleN(u, 500, r), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("uniform sample vs. UniformDist: P = %v, want large", res.P)
+	}
+	res, err = KolmogorovSmirnovTest(SampleN(NormalDist{0.5, 0.2}, 500, r), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P > 1e-6 {
+		t.Errorf("normal sample vs. UniformDist: P = %v, want tiny", res.P)
+	}
+}
+
+func TestTwoSampleKSTest(t *testing.T) {
+	res, err := TwoSampleKSTest([]float64{1, 2, 3}, []float64{2.5, 4, 5, 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// After 3, the CDFs are 1 and 1/4.
+	if want := 0.75; !aeq(want, res.D) || res.N1 != 3 || res.N2 != 4 {
+		t.Errorf("TwoSampleKSTest: got %+v, want D=%v, N1=3, N2=4", res, want)
+	}
+	// Ties across samples step both CDFs together.
+	res, err = TwoSampleKSTest([]float64{1, 2, 2}, []float64{2, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 0.5; !aeq(want, res.D) {
+		t.Errorf("TwoSampleKSTest with ties: D = %v, want %v", res.D, want)
+	}
+
+	if _, err := TwoSampleKSTest(nil, []float64{1}); err != ErrSampleSize {
+		t.Errorf("TwoSampleKSTest(nil, ...) returned error %v, want ErrSampleSize", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	res, err = TwoSampleKSTest(SampleN(NormalDist{0, 1}, 300, r), SampleN(ExponentialDist{1}, 400, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P > 1e-6 {
+		t.Errorf("different distributions: P = %v, want tiny", res.P)
+	}
+	res, err = TwoSampleKSTest(SampleN(NormalDist{0, 1}, 300, r), SampleN(NormalDist{0, 1}, 400, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("identical distributions: P = %v, want large", res.P)
+	}
+}
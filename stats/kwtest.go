@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+// A KruskalWallisTestResult is the result of a Kruskal-Wallis H
+// test.
+type KruskalWallisTestResult struct {
+	// N is the total number of values across all groups.
+	N int
+
+	// H is the Kruskal-Wallis H statistic, corrected for ties.
+	H float64
+
+	// DoF is the degrees of freedom of the chi-squared
+	// distribution used to compute P. This is one less than the
+	// number of groups.
+	DoF int
+
+	// P is the p-value of the test for the null hypothesis that
+	// all groups are drawn from the same distribution.
+	P float64
+}
+
+// KruskalWallisTest performs a Kruskal-Wallis H test of the null
+// hypothesis that groups are all drawn from the same population
+// against the alternative hypothesis that at least one group tends
+// to have larger or smaller values than the others. This is a
+// non-parametric analog of one-way ANOVA and a generalization of
+// MannWhitneyUTest to more than two groups.
+//
+// The p-value uses the chi-squared approximation to the distribution
+// of H, which is reasonable when each group has at least 5 values.
+//
+// This can fail with ErrSampleSize if there are fewer than two groups
+// or any group is empty, or ErrSamplesEqual if all values are equal.
+func KruskalWallisTest(groups ...[]float64) (*KruskalWallisTestResult, error) {
+	k := len(groups)
+	if k < 2 {
+		return nil, ErrSampleSize
+	}
+	var all []float64
+	for _, g := range groups {
+		if len(g) == 0 {
+			return nil, ErrSampleSize
+		}
+		all = append(all, g...)
+	}
+	ranks, ties := ranksAndTies(all, TieAverage)
+
+	n := float64(len(all))
+	h, start := 0.0, 0
+	for _, g := range groups {
+		r := 0.0
+		for _, rank := range ranks[start : start+len(g)] {
+			r += rank
+		}
+		h += r * r / float64(len(g))
+		start += len(g)
+	}
+	h = 12/(n*(n+1))*h - 3*(n+1)
+
+	// Correct for ties.
+	c := 1 - tieCorrection(ties)/(n*n*n-n)
+	if c == 0 {
+		return nil, ErrSamplesEqual
+	}
+	h /= c
+
+	res := &KruskalWallisTestResult{N: len(all), H: h, DoF: k - 1}
+	res.P = 1 - ChiSquaredDist{float64(k - 1)}.CDF(h)
+	return res, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKruskalWallisTest(t *testing.T) {
+	// Ranks are {1, 2, 4}, {3, 5, 6}, {7, 8, 9}, so the rank sums
+	// are 7, 14, and 24 and H = 12/90 * (49+196+576)/3 - 30.
+	res, err := KruskalWallisTest(
+		[]float64{1, 2, 4},
+		[]float64{3, 5, 6},
+		[]float64{7, 8, 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantH := 12.0/90*(49+196+576)/3 - 30
+	if res.N != 9 || res.DoF != 2 || !aeq(res.H, wantH) || !aeq(res.P, math.Exp(-wantH/2)) {
+		t.Errorf("KruskalWallisTest = %+v, want H=%v, P=%v", res, wantH, math.Exp(-wantH/2))
+	}
+
+	// With two groups, H is the square of the Mann-Whitney z
+	// statistic without continuity correction, including the tie
+	// correction.
+	x1 := []float64{1, 3, 3, 5, 7, 8, 8, 10, 12}
+	x2 := []float64{2, 3, 6, 8, 9, 11, 13, 14, 15, 16}
+	res, err = KruskalWallisTest(x1, x2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mw, err := MannWhitneyUTest(x1, x2, LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n1, n2 := float64(len(x1)), float64(len(x2))
+	N := n1 + n2
+	_, ties := ranksAndTies(append(append([]float64(nil), x1...), x2...), TieAverage)
+	σ := math.Sqrt(n1 * n2 * ((N + 1) - tieCorrection(ties)/(N*(N-1))) / 12)
+	z := (mw.U - n1*n2/2) / σ
+	if !aeq(res.H, z*z) {
+		t.Errorf("KruskalWallisTest(x1, x2).H = %v, want Mann-Whitney z² = %v", res.H, z*z)
+	}
+	if want := 2 * StdNormal.CDF(-math.Abs(z)); !aeq(res.P, want) {
+		t.Errorf("KruskalWallisTest(x1, x2).P = %v, want %v", res.P, want)
+	}
+
+	for _, groups := range [][][]float64{
+		nil,
+		{{1, 2}},
+		{{1, 2}, {}},
+	} {
+		if _, err := KruskalWallisTest(groups...); err != ErrSampleSize {
+			t.Errorf("KruskalWallisTest(%v) returned error %v, want ErrSampleSize", groups, err)
+		}
+	}
+	if _, err := KruskalWallisTest([]float64{1, 1}, []float64{1}); err != ErrSamplesEqual {
+		t.Errorf("KruskalWallisTest of equal values returned error %v, want ErrSamplesEqual", err)
+	}
+}
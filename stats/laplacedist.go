@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// LaplaceDist is a Laplace (or double exponential) distribution with
+// location Mu and scale B.
+type LaplaceDist struct {
+	// Mu is the location parameter. This is the mean, median,
+	// and mode of the distribution.
+	Mu float64
+
+	// B is the scale parameter. B > 0.
+	B float64
+}
+
+func (d LaplaceDist) PDF(x float64) float64 {
+	return math.Exp(-math.Abs(x-d.Mu)/d.B) / (2 * d.B)
+}
+
+func (d LaplaceDist) CDF(x float64) float64 {
+	if x < d.Mu {
+		return 0.5 * math.Exp((x-d.Mu)/d.B)
+	}
+	return 1 - 0.5*math.Exp(-(x-d.Mu)/d.B)
+}
+
+func (d LaplaceDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 {
+		return nan
+	} else if p == 0 {
+		return -inf
+	} else if p == 1 {
+		return inf
+	}
+	if p < 0.5 {
+		return d.Mu + d.B*math.Log(2*p)
+	}
+	return d.Mu - d.B*math.Log(2-2*p)
+}
+
+// Rand returns a random sample drawn from d using inverse transform
+// sampling.
+func (d LaplaceDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	for u == 0 {
+		if r == nil {
+			u = rand.Float64()
+		} else {
+			u = r.Float64()
+		}
+	}
+	return d.InvCDF(u)
+}
+
+func (d LaplaceDist) Bounds() (float64, float64) {
+	return d.InvCDF(0.001), d.InvCDF(0.999)
+}
+
+func (d LaplaceDist) Mean() float64 {
+	return d.Mu
+}
+
+func (d LaplaceDist) Variance() float64 {
+	return 2 * d.B * d.B
+}
+
+func (d LaplaceDist) Median() float64 {
+	return d.Mu
+}
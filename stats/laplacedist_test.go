@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestLaplaceDist(t *testing.T) {
+	d := LaplaceDist{Mu: 2, B: 0.5}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		1.5: math.Exp(-1),
+		2:   1,
+		2.5: math.Exp(-1),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-inf: 0,
+		1.5:  0.5 * math.Exp(-1),
+		2:    0.5,
+		2.5:  1 - 0.5*math.Exp(-1),
+		inf:  1,
+	})
+	testInvCDF(t, d, false)
+	testPDFIntegral(t, d, vec.Linspace(-2, 6, 9))
+
+	for _, delta := range []float64{0.1, 0.5, 1, 3} {
+		if l, r := d.PDF(d.Mu-delta), d.PDF(d.Mu+delta); l != r {
+			t.Errorf("%+v.PDF not symmetric at ±%v: %v != %v", d, delta, l, r)
+		}
+		if l, r := d.CDF(d.Mu-delta), 1-d.CDF(d.Mu+delta); !aeq(l, r) {
+			t.Errorf("%+v.CDF not symmetric at ±%v: %v != %v", d, delta, l, r)
+		}
+	}
+	if v := d.Variance(); v != 0.5 {
+		t.Errorf("%+v.Variance() = %v, want 0.5", d, v)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// A LinRegResult is the result of fitting the line
+// y = Intercept + Slope*x by least squares.
+type LinRegResult struct {
+	// Slope and Intercept are the coefficients of the fitted
+	// line.
+	Slope, Intercept float64
+
+	// SlopeStdErr and InterceptStdErr are the standard errors of
+	// Slope and Intercept.
+	SlopeStdErr, InterceptStdErr float64
+
+	// RSquared is the coefficient of determination, the fraction
+	// of the variance in y explained by the fit. It is NaN if y
+	// has zero variance.
+	RSquared float64
+
+	// DoF is the residual degrees of freedom, n-2.
+	DoF float64
+
+	// P is the two-sided p-value of the null hypothesis that the
+	// true slope is 0.
+	P float64
+}
+
+// Predict returns the value of the fitted line at x.
+func (r LinRegResult) Predict(x float64) float64 {
+	return r.Intercept + r.Slope*x
+}
+
+// LinearRegression fits the line y = Intercept + Slope*x to the
+// points (x[i], y[i]) by ordinary least squares. The p-value is
+// computed from the statistic t = Slope/SlopeStdErr, which has a
+// Student's t-distribution with n-2 degrees of freedom if the
+// residuals are independent and normally distributed with equal
+// variance.
+//
+// This can fail with ErrMismatchedSamples if x and y have different
+// lengths, ErrSampleSize if they have fewer than 3 values, or
+// ErrZeroVariance if x has zero variance.
+func LinearRegression(x, y []float64) (LinRegResult, error) {
+	if len(x) != len(y) {
+		return LinRegResult{}, ErrMismatchedSamples
+	}
+	n := len(x)
+	if n < 3 {
+		return LinRegResult{}, ErrSampleSize
+	}
+	return linearRegression(x, y, nil, n)
+}
+
+// WeightedLinearRegression fits the line y = Intercept + Slope*x to
+// the points (x[i], y[i]) by weighted least squares, minimizing
+// Σ weights[i]*(y[i] - Intercept - Slope*x[i])². If each y[i] has a
+// known variance, weighting by its reciprocal gives the best linear
+// unbiased estimate.
+//
+// As in R's lm, the residual variance is estimated from the weighted
+// residuals rather than assumed to be 1, so scaling all of the
+// weights by a constant does not change the result, and uniform
+// weights give the same result as LinearRegression. Points with zero
+// weight do not count toward the degrees of freedom.
+//
+// This can fail with ErrMismatchedSamples if x, y, and weights have
+// different lengths, ErrInvalidWeights if any weight is negative,
+// infinite, or NaN, ErrSampleSize if fewer than 3 weights are
+// positive, or ErrZeroVariance if x has zero weighted variance.
+func WeightedLinearRegression(x, y, weights []float64) (LinRegResult, error) {
+	if len(x) != len(y) || len(x) != len(weights) {
+		return LinRegResult{}, ErrMismatchedSamples
+	}
+	n := 0
+	for _, w := range weights {
+		if !(w >= 0) || math.IsInf(w, 1) {
+			return LinRegResult{}, ErrInvalidWeights
+		}
+		if w > 0 {
+			n++
+		}
+	}
+	if n < 3 {
+		return LinRegResult{}, ErrSampleSize
+	}
+	return linearRegression(x, y, weights, n)
+}
+
+// linearRegression fits a line to x and y by least squares weighted
+// by weights, or unweighted if weights is nil. n is the number of
+// points with non-zero weight.
+func linearRegression(x, y, weights []float64, n int) (LinRegResult, error) {
+	weight := func(i int) float64 {
+		if weights == nil {
+			return 1
+		}
+		return weights[i]
+	}
+
+	var sw, swx, swy float64
+	for i := range x {
+		w := weight(i)
+		sw += w
+		swx += w * x[i]
+		swy += w * y[i]
+	}
+	mx, my := swx/sw, swy/sw
+	var sxy, sxx, syy float64
+	for i := range x {
+		w, dx, dy := weight(i), x[i]-mx, y[i]-my
+		sxy += w * dx * dy
+		sxx += w * dx * dx
+		syy += w * dy * dy
+	}
+	if sxx == 0 {
+		return LinRegResult{}, ErrZeroVariance
+	}
+	slope := sxy / sxx
+	intercept := my - slope*mx
+
+	var sse float64
+	for i := range x {
+		e := y[i] - (intercept + slope*x[i])
+		sse += weight(i) * e * e
+	}
+	dof := float64(n - 2)
+	s2 := sse / dof
+	seSlope := math.Sqrt(s2 / sxx)
+	seIntercept := math.Sqrt(s2 * (1/sw + mx*mx/sxx))
+
+	r2 := nan
+	if syy != 0 {
+		// Round-off can push this slightly below 0 when the fit
+		// explains nothing.
+		r2 = math.Max(0, 1-sse/syy)
+	}
+
+	var p float64
+	switch {
+	case seSlope != 0:
+		t := slope / seSlope
+		p = 2 * TDist{dof}.CDF(-math.Abs(t))
+	case slope != 0:
+		// The points lie exactly on a non-horizontal line.
+		p = 0
+	default:
+		p = nan
+	}
+
+	return LinRegResult{
+		Slope:           slope,
+		Intercept:       intercept,
+		SlopeStdErr:     seSlope,
+		InterceptStdErr: seIntercept,
+		RSquared:        r2,
+		DoF:             dof,
+		P:               p,
+	}, nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLinearRegressionExact(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{1, 3, 5, 7, 9}
+	res, err := LinearRegression(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aeq(res.Slope, 2) || !aeq(res.Intercept, -1) {
+		t.Errorf("want y = -1 + 2x, got y = %v + %vx", res.Intercept, res.Slope)
+	}
+	if res.RSquared != 1 {
+		t.Errorf("want R² = 1, got %v", res.RSquared)
+	}
+	if res.SlopeStdErr != 0 || res.InterceptStdErr != 0 {
+		t.Errorf("want zero standard errors, got %v, %v", res.SlopeStdErr, res.InterceptStdErr)
+	}
+	if res.P != 0 {
+		t.Errorf("want P = 0, got %v", res.P)
+	}
+	if got := res.Predict(10); !aeq(got, 19) {
+		t.Errorf("want Predict(10) = 19, got %v", got)
+	}
+}
+
+func TestLinearRegressionNoisy(t *testing.T) {
+	// R's women data set. The expected values are from
+	// summary(lm(weight ~ height, women)).
+	height := []float64{58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72}
+	weight := []float64{115, 117, 120, 123, 126, 129, 132, 135, 139, 142, 146, 150, 154, 159, 164}
+	res, err := LinearRegression(height, weight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check := func(name string, got, want, tol float64) {
+		t.Helper()
+		if math.Abs(got-want) > tol*math.Abs(want) {
+			t.Errorf("want %s = %v, got %v", name, want, got)
+		}
+	}
+	check("Slope", res.Slope, 3.45, 1e-10)
+	check("Intercept", res.Intercept, -87.51667, 1e-6)
+	check("SlopeStdErr", res.SlopeStdErr, 0.09114, 1e-4)
+	check("InterceptStdErr", res.InterceptStdErr, 5.93694, 1e-6)
+	check("RSquared", res.RSquared, 0.991, 1e-3)
+	check("P", res.P, 1.09e-14, 1e-2)
+	if res.DoF != 13 {
+		t.Errorf("want DoF = 13, got %v", res.DoF)
+	}
+}
+
+func TestLinearRegressionFlat(t *testing.T) {
+	// A horizontal line leaves nothing to explain.
+	res, err := LinearRegression([]float64{1, 2, 3}, []float64{4, 4, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Slope != 0 || res.Intercept != 4 {
+		t.Errorf("want y = 4 + 0x, got y = %v + %vx", res.Intercept, res.Slope)
+	}
+	if !math.IsNaN(res.RSquared) || !math.IsNaN(res.P) {
+		t.Errorf("want NaN R² and P, got %v, %v", res.RSquared, res.P)
+	}
+}
+
+func TestLinearRegressionErrors(t *testing.T) {
+	if _, err := LinearRegression([]float64{1, 2, 3}, []float64{1, 2}); err != ErrMismatchedSamples {
+		t.Errorf("want ErrMismatchedSamples, got %v", err)
+	}
+	if _, err := LinearRegression([]float64{1, 2}, []float64{1, 2}); err != ErrSampleSize {
+		t.Errorf("want ErrSampleSize, got %v", err)
+	}
+	if _, err := LinearRegression([]float64{2, 2, 2}, []float64{1, 2, 3}); err != ErrZeroVariance {
+		t.Errorf("want ErrZeroVariance, got %v", err)
+	}
+}
+
+func TestWeightedLinearRegression(t *testing.T) {
+	height := []float64{58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72}
+	weight := []float64{115, 117, 120, 123, 126, 129, 132, 135, 139, 142, 146, 150, 154, 159, 164}
+	want, err := LinearRegression(height, weight)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Uniform weights of any scale give the unweighted fit.
+	for _, c := range []float64{1, 0.25, 10} {
+		w := make([]float64, len(height))
+		for i := range w {
+			w[i] = c
+		}
+		got, err := WeightedLinearRegression(height, weight, w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !aeq(got.Slope, want.Slope) || !aeq(got.Intercept, want.Intercept) ||
+			!aeq(got.SlopeStdErr, want.SlopeStdErr) || !aeq(got.InterceptStdErr, want.InterceptStdErr) ||
+			!aeq(got.RSquared, want.RSquared) || !aeq(got.P, want.P) || got.DoF != want.DoF {
+			t.Errorf("weights %v: want %+v, got %+v", c, want, got)
+		}
+	}
+}
+
+func TestWeightedLinearRegressionOutlier(t *testing.T) {
+	// All points lie on y = 2x except an outlier at x = 5.
+	x := []float64{0, 1, 2, 3, 4, 5}
+	y := []float64{0, 2, 4, 6, 8, 30}
+	ols, err := LinearRegression(x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := []float64{1, 1, 1, 1, 1, 1}
+	prev := ols.Slope
+	for _, wOut := range []float64{0.5, 0.1, 0.01} {
+		w[5] = wOut
+		got, err := WeightedLinearRegression(x, y, w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !(got.Slope < prev && got.Slope > 2) {
+			t.Errorf("outlier weight %v: want slope in (2, %v), got %v", wOut, prev, got.Slope)
+		}
+		prev = got.Slope
+	}
+
+	// With zero weight, the outlier is ignored entirely.
+	w[5] = 0
+	got, err := WeightedLinearRegression(x, y, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aeq(got.Slope, 2) || math.Abs(got.Intercept) > 1e-12 || got.DoF != 3 {
+		t.Errorf("zero outlier weight: want y = 0 + 2x with DoF 3, got %+v", got)
+	}
+}
+
+func TestWeightedLinearRegressionErrors(t *testing.T) {
+	x := []float64{1, 2, 3}
+	if _, err := WeightedLinearRegression(x, x, []float64{1, 1}); err != ErrMismatchedSamples {
+		t.Errorf("want ErrMismatchedSamples, got %v", err)
+	}
+	for _, w := range []float64{-1, nan, inf} {
+		if _, err := WeightedLinearRegression(x, x, []float64{1, w, 1}); err != ErrInvalidWeights {
+			t.Errorf("weight %v: want ErrInvalidWeights, got %v", w, err)
+		}
+	}
+	if _, err := WeightedLinearRegression(x, x, []float64{1, 0, 1}); err != ErrSampleSize {
+		t.Errorf("want ErrSampleSize, got %v", err)
+	}
+	if _, err := WeightedLinearRegression([]float64{1, 2, 2, 2}, []float64{1, 2, 3, 4}, []float64{0, 1, 1, 1}); err != ErrZeroVariance {
+		t.Errorf("want ErrZeroVariance, got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// LogNormalDist is a log-normal distribution. A random variable X is
+// log-normally distributed if log(X) is normally distributed with
+// mean Mu and standard deviation Sigma. It has support (0, ∞).
+type LogNormalDist struct {
+	// Mu and Sigma are the mean and standard deviation of the
+	// underlying normal distribution. Sigma > 0.
+	Mu, Sigma float64
+}
+
+func (d LogNormalDist) normal() NormalDist {
+	return NormalDist{Mu: d.Mu, Sigma: d.Sigma}
+}
+
+func (d LogNormalDist) PDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return d.normal().PDF(math.Log(x)) / x
+}
+
+func (d LogNormalDist) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return d.normal().CDF(math.Log(x))
+}
+
+func (d LogNormalDist) InvCDF(p float64) (x float64) {
+	return math.Exp(d.normal().InvCDF(p))
+}
+
+func (d LogNormalDist) Rand(r *rand.Rand) float64 {
+	return math.Exp(d.normal().Rand(r))
+}
+
+func (d LogNormalDist) Bounds() (float64, float64) {
+	l, h := d.normal().Bounds()
+	return math.Exp(l), math.Exp(h)
+}
+
+func (d LogNormalDist) Mean() float64 {
+	return math.Exp(d.Mu + d.Sigma*d.Sigma/2)
+}
+
+func (d LogNormalDist) Variance() float64 {
+	s2 := d.Sigma * d.Sigma
+	return math.Expm1(s2) * math.Exp(2*d.Mu+s2)
+}
+
+func (d LogNormalDist) Median() float64 {
+	return math.Exp(d.Mu)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestLogNormalDist(t *testing.T) {
+	d := LogNormalDist{Mu: 0, Sigma: 1}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-1: 0,
+		0:  0,
+		1:  invSqrt2Pi,
+		2:  invSqrt2Pi / 2 * math.Exp(-math.Ln2*math.Ln2/2),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-1: 0,
+		0:  0,
+		1:  0.5,
+	})
+	testFunc(t, fmt.Sprintf("%+v.InvCDF", d), d.InvCDF, map[float64]float64{
+		-0.1: nan,
+		0:    0,
+		0.5:  1,
+		1:    inf,
+		1.1:  nan,
+	})
+	testPDFIntegral(t, d, vec.Linspace(0, 10, 11))
+	testPDFIntegral(t, LogNormalDist{Mu: 2, Sigma: 0.5}, vec.Linspace(0.5, 30, 11))
+
+	if m := d.Mean(); !aeq(m, math.Exp(0.5)) {
+		t.Errorf("%+v.Mean() = %v, want %v", d, m, math.Exp(0.5))
+	}
+	if v, want := d.Variance(), (math.E-1)*math.E; !aeq(v, want) {
+		t.Errorf("%+v.Variance() = %v, want %v", d, v, want)
+	}
+}
+
+func TestLogNormalDistRand(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	d := LogNormalDist{Mu: 1, Sigma: 0.5}
+	xs := make([]float64, 100000)
+	for i := range xs {
+		xs[i] = d.Rand(r)
+	}
+	if m, want := Mean(xs), d.Mean(); math.Abs(m-want) > 0.02*want {
+		t.Errorf("%+v: sample mean %v, want %v", d, m, want)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// A MannKendallResult is the result of a Mann-Kendall trend test.
+type MannKendallResult struct {
+	// N is the length of the series.
+	N int
+
+	// S is the Mann-Kendall statistic, the number of pairs i < j
+	// with xs[i] < xs[j] minus the number with xs[i] > xs[j].
+	S int
+
+	// VarS is the variance of S under the null hypothesis,
+	// corrected for ties.
+	VarS float64
+
+	// Z is the normal approximation statistic for S, with a
+	// continuity correction.
+	Z float64
+
+	// Trend is the direction of the trend: 1 if the series tends
+	// to increase (S > 0), -1 if it tends to decrease (S < 0), or
+	// 0 if S is 0.
+	Trend int
+
+	// P is the two-sided p-value of the null hypothesis that
+	// there is no monotonic trend.
+	P float64
+}
+
+// MannKendallTest performs a Mann-Kendall test for a monotonic trend
+// in the series xs. This is a non-parametric test of the null
+// hypothesis that the values of xs are independent and identically
+// distributed, against the alternative that they tend to increase
+// or decrease over time. It is equivalent to testing Kendall's τ
+// between xs and the time index.
+//
+// The p-value uses a normal approximation to the distribution of S,
+// which is accurate for series of about 10 or more values.
+//
+// This can fail with ErrSampleSize if xs has fewer than 3 values, or
+// ErrZeroVariance if all values of xs are equal.
+func MannKendallTest(xs []float64) (MannKendallResult, error) {
+	n := len(xs)
+	if n < 3 {
+		return MannKendallResult{}, ErrSampleSize
+	}
+
+	// TODO: This is O(n²). As in KendallTau, a merge sort could
+	// count the inversions in O(n log n).
+	s := 0
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if xs[j] > xs[i] {
+				s++
+			} else if xs[j] < xs[i] {
+				s--
+			}
+		}
+	}
+
+	fn := float64(n)
+	v := fn * (fn - 1) * (2*fn + 5)
+	_, ties := ranksAndTies(xs, TieAverage)
+	for _, t := range ties {
+		t := float64(t)
+		v -= t * (t - 1) * (2*t + 5)
+	}
+	v /= 18
+	if v == 0 {
+		return MannKendallResult{}, ErrZeroVariance
+	}
+
+	var z float64
+	var trend int
+	switch {
+	case s > 0:
+		z, trend = float64(s-1)/math.Sqrt(v), 1
+	case s < 0:
+		z, trend = float64(s+1)/math.Sqrt(v), -1
+	}
+	p := 2 * StdNormal.CDF(-math.Abs(z))
+	return MannKendallResult{N: n, S: s, VarS: v, Z: z, Trend: trend, P: p}, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestMannKendallTest(t *testing.T) {
+	check := func(xs []float64, s int, v, z float64, trend int, p float64) {
+		t.Helper()
+		res, err := MannKendallTest(xs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.N != len(xs) || res.S != s || !aeq(res.VarS, v) || !aeq(res.Z, z) || res.Trend != trend || !aeq(res.P, p) {
+			t.Errorf("MannKendallTest(%v) = %+v, want S=%d VarS=%v Z=%v Trend=%d P=%v", xs, res, s, v, z, trend, p)
+		}
+	}
+
+	// A strictly increasing series. Every pair is increasing, so
+	// S = 10·9/2 and VarS = 10·9·25/18.
+	inc := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	check(inc, 45, 125, 3.9354796403996297, 1, 8.303070332644999e-05)
+
+	// The same series reversed has the opposite trend.
+	dec := []float64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+	check(dec, -45, 125, -3.9354796403996297, -1, 8.303070332644999e-05)
+
+	// With ties, VarS = (4·3·13 - 2·1·9)/18.
+	check([]float64{1, 2, 2, 3}, 5, 138.0/18, 1.4446302370292303, 1, 0.1485617748918687)
+
+	// A flat, noisy series has no significant trend.
+	r := rand.New(rand.NewSource(1))
+	flat := make([]float64, 50)
+	for i := range flat {
+		flat[i] = 10 + r.NormFloat64()
+	}
+	res, err := MannKendallTest(flat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("MannKendallTest of flat series = %+v, want P >= 0.05", res)
+	}
+}
+
+func TestMannKendallTestErrors(t *testing.T) {
+	if _, err := MannKendallTest([]float64{1, 2}); err != ErrSampleSize {
+		t.Errorf("want ErrSampleSize, got %v", err)
+	}
+	if _, err := MannKendallTest([]float64{3, 3, 3, 3}); err != ErrZeroVariance {
+		t.Errorf("want ErrZeroVariance, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/jgbaldwinbrown/go-moremath/mathx"
+)
+
+// NegativeBinomialDist is a negative binomial distribution.
+//
+// This is the distribution of the number of failures before the R'th
+// success in a sequence of independent Bernoulli trials, each with
+// probability of success P. It has support {0, 1, 2, ...}.
+//
+// R may be any positive real number, in which case this is also
+// known as the Pólya distribution. This is commonly used to model
+// overdispersed count data, since its variance always exceeds its
+// mean.
+//
+// If R=1, this is equivalent to the geometric distribution.
+type NegativeBinomialDist struct {
+	// R is the number of successes. R > 0.
+	R float64
+
+	// P is the probability of success in each trial. 0 < P <= 1.
+	P float64
+}
+
+// PMF is the probability of exactly int(k) failures before the R'th
+// success.
+func (d NegativeBinomialDist) PMF(k float64) float64 {
+	k = math.Floor(k)
+	if k < 0 {
+		return 0
+	} else if k == 0 {
+		// Avoid 0 * log(0) when P == 1.
+		return math.Pow(d.P, d.R)
+	}
+	// Compute the generalized binomial coefficient
+	// (k+R-1 choose k) in log space to avoid overflow.
+	lc := lgamma(k+d.R) - lgamma(k+1) - lgamma(d.R)
+	return math.Exp(lc + d.R*math.Log(d.P) + k*math.Log1p(-d.P))
+}
+
+// CDF is the probability of int(k) or fewer failures before the R'th
+// success.
+func (d NegativeBinomialDist) CDF(k float64) float64 {
+	k = math.Floor(k)
+	if k < 0 {
+		return 0
+	}
+	return mathx.BetaInc(d.P, d.R, k+1)
+}
+
+// Rand returns a random sample drawn from d by inverting the CDF.
+func (d NegativeBinomialDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	if r == nil {
+		u = rand.Float64()
+	} else {
+		u = r.Float64()
+	}
+	return float64(invDiscreteCDF(d.CDF, u, 0, -1))
+}
+
+// Bounds returns 0 and the smallest k such that Pr[X > k] <= 1e-9.
+func (d NegativeBinomialDist) Bounds() (float64, float64) {
+	// Pr[X > k] = 1 - I_P(R, k+1) = I_(1-P)(k+1, R), which
+	// doesn't suffer from cancellation.
+	tail := func(k float64) float64 {
+		return mathx.BetaInc(1-d.P, k+1, d.R)
+	}
+	if d.P == 1 {
+		return 0, 0
+	}
+	lo, hi := -1.0, 1.0
+	for tail(hi) > discreteTail {
+		lo, hi = hi, hi*2
+	}
+	// Invariant: tail(lo) > discreteTail >= tail(hi).
+	for hi-lo > 1 {
+		mid := math.Floor((lo + hi) / 2)
+		if tail(mid) > discreteTail {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return 0, hi
+}
+
+func (d NegativeBinomialDist) Step() float64 {
+	return 1
+}
+
+func (d NegativeBinomialDist) Mean() float64 {
+	return d.R * (1 - d.P) / d.P
+}
+
+func (d NegativeBinomialDist) Variance() float64 {
+	return d.R * (1 - d.P) / (d.P * d.P)
+}
+
+// Mode returns the mode of d. If R > 1 and (R-1)(1-P)/P is an
+// integer, both it and one less are modes and Mode returns the
+// larger.
+func (d NegativeBinomialDist) Mode() float64 {
+	if d.R <= 1 {
+		return 0
+	}
+	return math.Floor((d.R - 1) * (1 - d.P) / d.P)
+}
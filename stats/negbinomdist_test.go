@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNegativeBinomialDist(t *testing.T) {
+	dist := NegativeBinomialDist{R: 3, P: 0.5}
+	testFunc(t, fmt.Sprintf("%+v.PMF", dist), dist.PMF,
+		map[float64]float64{
+			-1:  0,
+			0:   0.125,
+			1:   0.1875,
+			2:   0.1875,
+			2.5: 0.1875,
+			3:   0.15625,
+		})
+	testDiscreteCDF(t, fmt.Sprintf("%+v.CDF", dist), dist)
+	testDiscreteCDF(t, "NegativeBinomialDist{R: 2.5, P: 0.1}.CDF",
+		NegativeBinomialDist{R: 2.5, P: 0.1})
+	certain := NegativeBinomialDist{R: 2, P: 1}
+	testFunc(t, fmt.Sprintf("%+v.PMF", certain), certain.PMF,
+		map[float64]float64{-1: 0, 0: 1, 1: 0, 2: 0})
+
+	if m := dist.Mean(); m != 3 {
+		t.Errorf("%+v.Mean() = %v, want 3", dist, m)
+	}
+	if v := dist.Variance(); v != 6 {
+		t.Errorf("%+v.Variance() = %v, want 6", dist, v)
+	}
+
+	// Large counts should not overflow.
+	big := NegativeBinomialDist{R: 500, P: 0.01}
+	if p := big.PMF(big.Mean()); !(p > 0 && p < 1) {
+		t.Errorf("%+v.PMF(%v) = %v", big, big.Mean(), p)
+	}
+}
+
+func TestNegativeBinomialDistGeometric(t *testing.T) {
+	for _, p := range []float64{0.1, 0.5, 0.9} {
+		nb := NegativeBinomialDist{R: 1, P: p}
+		geom := GeometricDist{P: p}
+		for k := 0.0; k < 50; k++ {
+			if a, b := nb.PMF(k), geom.PMF(k); !aeq(a, b) {
+				t.Errorf("%+v.PMF(%v) = %v, but %+v.PMF(%v) = %v", nb, k, a, geom, k, b)
+			}
+			if a, b := nb.CDF(k), geom.CDF(k); math.Abs(a-b) > 1e-12 {
+				t.Errorf("%+v.CDF(%v) = %v, but %+v.CDF(%v) = %v", nb, k, a, geom, k, b)
+			}
+		}
+		_, h1 := nb.Bounds()
+		_, h2 := geom.Bounds()
+		if h1 != h2 {
+			t.Errorf("%+v.Bounds() upper = %v, but %+v.Bounds() upper = %v", nb, h1, geom, h2)
+		}
+	}
+}
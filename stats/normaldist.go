@@ -147,3 +147,24 @@ func (n NormalDist) Mean() float64 {
 func (n NormalDist) Variance() float64 {
 	return n.Sigma * n.Sigma
 }
+
+func (n NormalDist) Mode() float64 {
+	return n.Mu
+}
+
+func (n NormalDist) Median() float64 {
+	return n.Mu
+}
+
+// Entropy returns the differential entropy of n in nats.
+func (n NormalDist) Entropy() float64 {
+	return 0.5 * math.Log(2*math.Pi*math.E*n.Sigma*n.Sigma)
+}
+
+func (n NormalDist) Skewness() float64 {
+	return 0
+}
+
+func (n NormalDist) ExcessKurtosis() float64 {
+	return 0
+}
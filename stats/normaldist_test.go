@@ -31,3 +31,15 @@ func TestNormalDist(t *testing.T) {
 	testInvCDF(t, d, false)
 	testInvCDF(t, d2, false)
 }
+
+func TestNormalEntropy(t *testing.T) {
+	testFunc(t, "StdNormal.Entropy", func(float64) float64 { return StdNormal.Entropy() },
+		map[float64]float64{0: 1.4189385332046727})
+	for _, d := range []NormalDist{StdNormal, {5, 0.1}, {-2, 10}} {
+		want := 0.5 * math.Log(2*math.Pi*math.E*d.Sigma*d.Sigma)
+		if got := d.Entropy(); !aeq(want, got) {
+			t.Errorf("%+v.Entropy() = %v, want %v", d, got, want)
+		}
+		testEntropy(t, d, d.Mu-12*d.Sigma, d.Mu+12*d.Sigma)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// GrubbsOutliers returns the indices of outliers in xs, in increasing
+// order, as detected by iterating the two-sided Grubbs test at
+// significance level alpha.
+//
+// Each iteration computes the Grubbs statistic
+//
+//	G = max |x_i - x̄| / s
+//
+// over the values not yet flagged, where x̄ and s are their mean and
+// standard deviation. If G exceeds the critical value
+//
+//	(n-1)/√n · √(t²/(n-2+t²))
+//
+// where t is the upper α/(2n) critical value of Student's
+// t-distribution with n-2 degrees of freedom, the most extreme value
+// is flagged and the test is repeated on the rest. Iteration stops
+// when no value is flagged or fewer than 3 values remain.
+//
+// The Grubbs test assumes the non-outlying values are normally
+// distributed.
+func GrubbsOutliers(xs []float64, alpha float64) []int {
+	idx := make([]int, len(xs))
+	for i := range idx {
+		idx[i] = i
+	}
+	var outliers []int
+	vals := make([]float64, 0, len(xs))
+	for len(idx) >= 3 {
+		vals = vals[:0]
+		for _, i := range idx {
+			vals = append(vals, xs[i])
+		}
+		mean, sd := Mean(vals), StdDev(vals)
+		if sd == 0 {
+			break
+		}
+		worst, g := 0, 0.0
+		for j, x := range vals {
+			if d := math.Abs(x-mean) / sd; d > g {
+				worst, g = j, d
+			}
+		}
+
+		n := float64(len(vals))
+		t := TDist{n - 2}.InvCDF(1 - alpha/(2*n))
+		crit := (n - 1) / math.Sqrt(n) * math.Sqrt(t*t/(n-2+t*t))
+		if !(g > crit) {
+			break
+		}
+		outliers = append(outliers, idx[worst])
+		idx = append(idx[:worst], idx[worst+1:]...)
+	}
+	sort.Ints(outliers)
+	return outliers
+}
+
+// IQRFences returns Tukey's fences for xs, Q1 - k*IQR and Q3 + k*IQR,
+// where Q1 and Q3 are the first and third quartiles (as computed by
+// Sample.Quantile) and IQR = Q3 - Q1. Values outside the fences are
+// conventionally considered outliers for k = 1.5 and "far out" for
+// k = 3.
+func IQRFences(xs []float64, k float64) (lo, hi float64) {
+	s := Sample{Xs: xs}.Copy().Sort()
+	q1, q3 := s.Quantile(0.25), s.Quantile(0.75)
+	iqr := q3 - q1
+	return q1 - k*iqr, q3 + k*iqr
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGrubbsOutliers(t *testing.T) {
+	check := func(xs []float64, alpha float64, want []int) {
+		t.Helper()
+		got := GrubbsOutliers(xs, alpha)
+		if len(got) != len(want) {
+			t.Errorf("GrubbsOutliers(%v, %v) = %v, want %v", xs, alpha, got, want)
+			return
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Errorf("GrubbsOutliers(%v, %v) = %v, want %v", xs, alpha, got, want)
+				return
+			}
+		}
+	}
+
+	// NIST/SEMATECH e-Handbook example: G = 2.4687 exceeds the
+	// critical value of 2.032 and the remaining values show no
+	// outliers.
+	check([]float64{199.31, 199.53, 200.19, 200.82, 201.92, 201.95, 202.18, 245.57}, 0.05, []int{7})
+
+	// Injected outliers on both sides. The first iteration flags
+	// 25 and the second flags 7.
+	xs := []float64{10.1, 25, 9.9, 10.0, 10.2, 9.8, 10.05, 9.95, 7, 10.15, 9.85, 10.0}
+	check(xs, 0.05, []int{1, 8})
+
+	// Two equally extreme outliers mask each other: neither
+	// stands out from the inflated standard deviation. This is a
+	// known limitation of the Grubbs test.
+	xs = []float64{10.1, 25, 9.9, 10.0, 10.2, 9.8, 10.05, 9.95, -5, 10.15, 9.85, 10.0}
+	check(xs, 0.05, nil)
+
+	// Clean normal data has no outliers.
+	r := rand.New(rand.NewSource(1))
+	check(SampleN(NormalDist{Mu: 0, Sigma: 1}, 50, r), 0.01, nil)
+
+	check([]float64{1, 1, 1, 1}, 0.05, nil)
+	check([]float64{1, 100}, 0.05, nil)
+}
+
+func TestIQRFences(t *testing.T) {
+	xs := []float64{1, 2, 3, 4, 5, 6, 7, 100}
+	s := Sample{Xs: xs}
+	q1, q3 := s.Quantile(0.25), s.Quantile(0.75)
+	lo, hi := IQRFences(xs, 1.5)
+	if !aeq(lo, q1-1.5*(q3-q1)) || !aeq(hi, q3+1.5*(q3-q1)) {
+		t.Errorf("IQRFences(%v, 1.5) = %v, %v, want %v, %v", xs, lo, hi, q1-1.5*(q3-q1), q3+1.5*(q3-q1))
+	}
+	for _, x := range xs[:7] {
+		if x < lo || x > hi {
+			t.Errorf("IQRFences(%v, 1.5) = [%v, %v] excludes %v", xs, lo, hi, x)
+		}
+	}
+	if hi >= 100 {
+		t.Errorf("IQRFences(%v, 1.5) = [%v, %v] includes outlier 100", xs, lo, hi)
+	}
+	if xs[7] != 100 {
+		t.Errorf("IQRFences modified its input")
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// A MultipleComparisonMethod specifies how AdjustPValues corrects
+// p-values for multiple comparisons.
+type MultipleComparisonMethod int
+
+//go:generate stringer -type MultipleComparisonMethod
+
+const (
+	// AdjustBonferroni multiplies each p-value by the number of
+	// tests. This controls the family-wise error rate, but is
+	// conservative.
+	AdjustBonferroni MultipleComparisonMethod = iota
+
+	// AdjustHolm is the Holm-Bonferroni step-down method. It
+	// controls the family-wise error rate and is uniformly more
+	// powerful than AdjustBonferroni.
+	AdjustHolm
+
+	// AdjustBenjaminiHochberg is the Benjamini-Hochberg step-up
+	// method. It controls the false discovery rate for
+	// independent (or positively dependent) tests.
+	AdjustBenjaminiHochberg
+)
+
+// AdjustPValues returns pvals adjusted for multiple comparisons using
+// method. The adjusted p-values are in the same order as pvals and
+// can be compared directly against the desired significance level.
+// They are computed as by R's p.adjust.
+//
+// NaN p-values are passed through unchanged and are not counted as
+// tests.
+func AdjustPValues(pvals []float64, method MultipleComparisonMethod) []float64 {
+	out := make([]float64, len(pvals))
+	// idx is the indexes of the non-NaN p-values, in increasing
+	// order of p-value.
+	var idx []int
+	for i, p := range pvals {
+		out[i] = p
+		if !math.IsNaN(p) {
+			idx = append(idx, i)
+		}
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return pvals[idx[i]] < pvals[idx[j]] })
+	m := float64(len(idx))
+
+	switch method {
+	case AdjustBonferroni:
+		for _, i := range idx {
+			out[i] = math.Min(1, m*pvals[i])
+		}
+	case AdjustHolm:
+		// The i'th smallest p-value is multiplied by m-i, and
+		// then made monotonic from the smallest up.
+		max := 0.0
+		for rank, i := range idx {
+			max = math.Max(max, (m-float64(rank))*pvals[i])
+			out[i] = math.Min(1, max)
+		}
+	case AdjustBenjaminiHochberg:
+		// The i'th smallest p-value is multiplied by m/(i+1),
+		// and then made monotonic from the largest down.
+		min := 1.0
+		for rank := len(idx) - 1; rank >= 0; rank-- {
+			i := idx[rank]
+			min = math.Min(min, m/float64(rank+1)*pvals[i])
+			out[i] = min
+		}
+	default:
+		panic(fmt.Sprintf("unknown multiple comparison method %v", method))
+	}
+	return out
+}
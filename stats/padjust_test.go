@@ -0,0 +1,67 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAdjustPValues(t *testing.T) {
+	// Worked by hand, with m = 4 tests. Sorted, the p-values are
+	// 0.005, 0.01, 0.03, 0.04.
+	//
+	// Holm multiplies these by 4, 3, 2, 1 to get 0.02, 0.03, 0.06,
+	// 0.04, and the running maximum makes the last 0.06.
+	//
+	// Benjamini-Hochberg multiplies them by 4/1, 4/2, 4/3, 4/4 to
+	// get 0.02, 0.02, 0.04, 0.04, which is already monotonic.
+	pvals := []float64{0.01, 0.04, nan, 0.03, 0.005}
+	for _, c := range []struct {
+		method MultipleComparisonMethod
+		want   []float64
+	}{
+		{AdjustBonferroni, []float64{0.04, 0.16, nan, 0.12, 0.02}},
+		{AdjustHolm, []float64{0.03, 0.06, nan, 0.06, 0.02}},
+		{AdjustBenjaminiHochberg, []float64{0.02, 0.04, nan, 0.04, 0.02}},
+	} {
+		got := AdjustPValues(pvals, c.method)
+		for i := range c.want {
+			if math.IsNaN(c.want[i]) {
+				if !math.IsNaN(got[i]) {
+					t.Errorf("%v: result[%d] = %v, want NaN", c.method, i, got[i])
+				}
+			} else if !aeq(got[i], c.want[i]) {
+				t.Errorf("%v: result[%d] = %v, want %v", c.method, i, got[i], c.want[i])
+			}
+		}
+	}
+
+	// Adjusted p-values are capped at 1, and BH enforces
+	// monotonicity from the top.
+	pvals = []float64{0.5, 0.9, 0.8}
+	for _, c := range []struct {
+		method MultipleComparisonMethod
+		want   []float64
+	}{
+		{AdjustBonferroni, []float64{1, 1, 1}},
+		{AdjustHolm, []float64{1, 1, 1}},
+		{AdjustBenjaminiHochberg, []float64{0.9, 0.9, 0.9}},
+	} {
+		got := AdjustPValues(pvals, c.method)
+		for i := range c.want {
+			if !aeq(got[i], c.want[i]) {
+				t.Errorf("%v(%v): result[%d] = %v, want %v", c.method, pvals, i, got[i], c.want[i])
+			}
+		}
+	}
+
+	if got := AdjustPValues(nil, AdjustHolm); len(got) != 0 {
+		t.Errorf("AdjustPValues(nil) = %v, want []", got)
+	}
+	if got := AdjustBenjaminiHochberg.String(); got != "AdjustBenjaminiHochberg" {
+		t.Errorf("String() = %q", got)
+	}
+}
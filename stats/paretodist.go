@@ -0,0 +1,86 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ParetoDist is a Pareto (type I) distribution with scale Xm and
+// shape Alpha. It has support [Xm, ∞).
+//
+// This is a power law distribution: the probability that X exceeds x
+// is (Xm/x)^Alpha.
+type ParetoDist struct {
+	// Xm is the scale parameter, which is the minimum possible
+	// value of X. Xm > 0.
+	Xm float64
+
+	// Alpha is the shape parameter, or tail index. Alpha > 0.
+	Alpha float64
+}
+
+func (d ParetoDist) PDF(x float64) float64 {
+	if x < d.Xm {
+		return 0
+	}
+	return d.Alpha / x * math.Pow(d.Xm/x, d.Alpha)
+}
+
+func (d ParetoDist) CDF(x float64) float64 {
+	if x <= d.Xm {
+		return 0
+	}
+	return -math.Expm1(d.Alpha * math.Log(d.Xm/x))
+}
+
+func (d ParetoDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 {
+		return nan
+	} else if p == 0 {
+		return d.Xm
+	} else if p == 1 {
+		return inf
+	}
+	return d.Xm * math.Exp(-math.Log1p(-p)/d.Alpha)
+}
+
+// Rand returns a random sample drawn from d using inverse transform
+// sampling.
+func (d ParetoDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	if r == nil {
+		u = rand.Float64()
+	} else {
+		u = r.Float64()
+	}
+	return d.InvCDF(u)
+}
+
+func (d ParetoDist) Bounds() (float64, float64) {
+	return d.Xm, d.InvCDF(0.999)
+}
+
+// Mean returns the mean of d. This is +Inf if Alpha <= 1.
+func (d ParetoDist) Mean() float64 {
+	if d.Alpha <= 1 {
+		return inf
+	}
+	return d.Alpha * d.Xm / (d.Alpha - 1)
+}
+
+// Variance returns the variance of d. This is +Inf if Alpha <= 2.
+func (d ParetoDist) Variance() float64 {
+	if d.Alpha <= 2 {
+		return inf
+	}
+	a1 := d.Alpha - 1
+	return d.Xm * d.Xm * d.Alpha / (a1 * a1 * (d.Alpha - 2))
+}
+
+func (d ParetoDist) Median() float64 {
+	return d.Xm * math.Pow(2, 1/d.Alpha)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestParetoDist(t *testing.T) {
+	d := ParetoDist{Xm: 2, Alpha: 3}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		1: 0,
+		2: 1.5,
+		4: 3 * 8 / math.Pow(4, 4),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		1:   0,
+		2:   0,
+		4:   1 - 1.0/8,
+		inf: 1,
+	})
+	testPDFIntegral(t, d, vec.Linspace(2, 10, 9))
+	for _, p := range vec.Linspace(0, 1, 11) {
+		if got := d.CDF(d.InvCDF(p)); !aeq(p, got) {
+			t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+		}
+	}
+	if got := d.InvCDF(0); got != d.Xm {
+		t.Errorf("%+v.InvCDF(0) = %v, want %v", d, got, d.Xm)
+	}
+
+	if m := d.Mean(); m != 3 {
+		t.Errorf("%+v.Mean() = %v, want 3", d, m)
+	}
+	if v := d.Variance(); v != 3 {
+		t.Errorf("%+v.Variance() = %v, want 3", d, v)
+	}
+	// Divergent moments.
+	if m := (ParetoDist{1, 1}).Mean(); !math.IsInf(m, 1) {
+		t.Errorf("Alpha=1 Mean() = %v, want +Inf", m)
+	}
+	if v := (ParetoDist{1, 2}).Variance(); !math.IsInf(v, 1) {
+		t.Errorf("Alpha=2 Variance() = %v, want +Inf", v)
+	}
+}
+
+func TestParetoDistTail(t *testing.T) {
+	// The survival function 1-CDF(x) has slope -Alpha in log-log
+	// space.
+	for _, alpha := range []float64{0.5, 1.5, 3} {
+		d := ParetoDist{Xm: 1, Alpha: alpha}
+		x1, x2 := 10.0, 1000.0
+		slope := (math.Log(1-d.CDF(x2)) - math.Log(1-d.CDF(x1))) /
+			(math.Log(x2) - math.Log(x1))
+		if !aeq(-alpha, slope) {
+			t.Errorf("%+v: log-log tail slope %v, want %v", d, slope, -alpha)
+		}
+	}
+}
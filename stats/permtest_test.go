@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPermutationTest(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// Clearly separated groups. Only 2 of the C(16, 8) = 12870
+	// groupings are as extreme as the observed one.
+	a := []float64{10.1, 10.5, 9.8, 10.9, 11.2, 10.4, 9.9, 10.7}
+	b := []float64{1.2, 0.8, 2.1, 1.5, 0.3, 1.9, 1.1, 0.6}
+	if p := PermutationTest(a, b, nil, 10000, r); p > 0.01 {
+		t.Errorf("PermutationTest on separated groups = %v, want < 0.01", p)
+	}
+	// The inputs are not modified.
+	if a[0] != 10.1 || b[0] != 1.2 {
+		t.Errorf("PermutationTest modified its inputs")
+	}
+
+	// Groups from the same distribution should usually have a
+	// large p-value.
+	dist := NormalDist{Mu: 0, Sigma: 1}
+	x, y := SampleN(dist, 20, r), SampleN(dist, 25, r)
+	if p := PermutationTest(x, y, nil, 2000, r); p < 0.05 {
+		t.Errorf("PermutationTest on identically distributed groups = %v, want > 0.05", p)
+	}
+
+	// When every grouping gives the same statistic, every
+	// permutation is as extreme as the observed one.
+	same := []float64{3, 3, 3}
+	if p := PermutationTest(same, same, nil, 100, r); p != 1 {
+		t.Errorf("PermutationTest on constant data = %v, want 1", p)
+	}
+
+	// A custom statistic: the difference of medians.
+	med := func(a, b []float64) float64 {
+		return Sample{Xs: a}.Quantile(0.5) - Sample{Xs: b}.Quantile(0.5)
+	}
+	if p := PermutationTest(a, b, med, 2000, r); p > 0.01 {
+		t.Errorf("PermutationTest with median statistic = %v, want < 0.01", p)
+	}
+}
+
+func TestPermutationTestMatchesTTest(t *testing.T) {
+	// For roughly normal data, the permutation p-value should be
+	// close to the t-test p-value.
+	a := []float64{5.1, 4.8, 6.2, 5.5, 5.9, 4.6, 6.1, 5.3, 5.0, 5.7}
+	b := []float64{4.4, 5.0, 4.1, 5.2, 4.8, 3.9, 4.6, 5.3, 4.2, 4.7}
+	res, err := TwoSampleTTest(Sample{Xs: a}, Sample{Xs: b}, LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := PermutationTest(a, b, nil, 20000, rand.New(rand.NewSource(1)))
+	if math.Abs(p-res.P) > 0.5*res.P+0.002 {
+		t.Errorf("PermutationTest p = %v, but t-test p = %v", p, res.P)
+	}
+}
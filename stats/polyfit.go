@@ -0,0 +1,130 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// PolynomialFit fits a polynomial of the given degree to the points
+// (x[i], y[i]) by least squares. It returns the coefficients from
+// lowest to highest order, so the fitted polynomial is
+// coeffs[0] + coeffs[1]*x + ... + coeffs[degree]*x^degree. See Eval.
+//
+// The fit is computed by a Householder QR factorization of the
+// Vandermonde matrix of x, which avoids squaring its condition
+// number as solving the normal equations would. Still, high degrees
+// or x values far from 0 make the problem ill-conditioned.
+//
+// This can fail with ErrMismatchedSamples if x and y have different
+// lengths, or ErrSampleSize if x has fewer than degree+1 distinct
+// values (in particular, if degree >= len(x)). PolynomialFit panics
+// if degree is negative.
+func PolynomialFit(x, y []float64, degree int) ([]float64, error) {
+	if len(x) != len(y) {
+		return nil, ErrMismatchedSamples
+	}
+	if degree < 0 {
+		panic("degree must be non-negative")
+	}
+	m := degree + 1
+	if countDistinct(x) < m {
+		return nil, ErrSampleSize
+	}
+
+	// a[j] is column j of the Vandermonde matrix, x^j.
+	n := len(x)
+	a := make([][]float64, m)
+	for j := range a {
+		a[j] = make([]float64, n)
+		for i := range x {
+			if j == 0 {
+				a[j][i] = 1
+			} else {
+				a[j][i] = a[j-1][i] * x[i]
+			}
+		}
+	}
+	b := append([]float64(nil), y...)
+
+	// Reduce a to upper triangular R by Householder reflections,
+	// applying the same reflections to b. The strict upper
+	// triangle of R is left in a; its diagonal is in diag.
+	diag := make([]float64, m)
+	for k := 0; k < m; k++ {
+		v := a[k]
+		norm := 0.0
+		for i := k; i < n; i++ {
+			norm = math.Hypot(norm, v[i])
+		}
+		if norm == 0 {
+			return nil, ErrSampleSize
+		}
+		// Choose the sign that avoids cancellation in v[k].
+		if v[k] > 0 {
+			norm = -norm
+		}
+		diag[k] = norm
+		v[k] -= norm
+		vv := 0.0
+		for i := k; i < n; i++ {
+			vv += v[i] * v[i]
+		}
+		reflect := func(c []float64) {
+			d := 0.0
+			for i := k; i < n; i++ {
+				d += v[i] * c[i]
+			}
+			f := 2 * d / vv
+			for i := k; i < n; i++ {
+				c[i] -= f * v[i]
+			}
+		}
+		for j := k + 1; j < m; j++ {
+			reflect(a[j])
+		}
+		reflect(b)
+	}
+
+	// Solve R coeffs = b by back substitution.
+	coeffs := make([]float64, m)
+	for i := m - 1; i >= 0; i-- {
+		s := b[i]
+		for j := i + 1; j < m; j++ {
+			s -= a[j][i] * coeffs[j]
+		}
+		coeffs[i] = s / diag[i]
+	}
+	return coeffs, nil
+}
+
+// countDistinct returns the number of distinct values in xs.
+func countDistinct(xs []float64) int {
+	if len(xs) == 0 {
+		return 0
+	}
+	s := append([]float64(nil), xs...)
+	sort.Float64s(s)
+	count := 1
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[i-1] {
+			count++
+		}
+	}
+	return count
+}
+
+// Eval returns the value at x of the polynomial with coefficients
+// coeffs, given from lowest to highest order as returned by
+// PolynomialFit. If coeffs is empty, Eval returns 0.
+func Eval(coeffs []float64, x float64) float64 {
+	// Horner's method.
+	y := 0.0
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = y*x + coeffs[i]
+	}
+	return y
+}
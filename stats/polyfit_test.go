@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPolynomialFitExact(t *testing.T) {
+	// y = 3 - 2x + 0.5x²
+	want := []float64{3, -2, 0.5}
+	x := []float64{-2, -1, 0, 1, 2, 3, 4, 5}
+	y := make([]float64, len(x))
+	for i := range x {
+		y[i] = Eval(want, x[i])
+	}
+	got, err := PolynomialFit(x, y, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("want %d coefficients, got %v", len(want), got)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-12 {
+			t.Errorf("want coefficients %v, got %v", want, got)
+			break
+		}
+	}
+
+	// Overfitting with a higher degree still recovers the
+	// polynomial, with zero higher-order terms.
+	got, err = PolynomialFit(x, y, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want4 := []float64{3, -2, 0.5, 0, 0}
+	for i := range want4 {
+		if math.Abs(got[i]-want4[i]) > 1e-10 {
+			t.Errorf("degree 4: want coefficients %v, got %v", want4, got)
+			break
+		}
+	}
+}
+
+func TestPolynomialFitLinear(t *testing.T) {
+	// A degree 1 fit is the same as LinearRegression.
+	height := []float64{58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72}
+	weight := []float64{115, 117, 120, 123, 126, 129, 132, 135, 139, 142, 146, 150, 154, 159, 164}
+	lr, err := LinearRegression(height, weight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := PolynomialFit(height, weight, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !aeq(got[0], lr.Intercept) || !aeq(got[1], lr.Slope) {
+		t.Errorf("want %v, %v, got %v", lr.Intercept, lr.Slope, got)
+	}
+
+	// A degree 0 fit is the mean.
+	got, err = PolynomialFit(height, weight, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || !aeq(got[0], Mean(weight)) {
+		t.Errorf("degree 0: want [%v], got %v", Mean(weight), got)
+	}
+}
+
+func TestPolynomialFitErrors(t *testing.T) {
+	if _, err := PolynomialFit([]float64{1, 2, 3}, []float64{1, 2}, 1); err != ErrMismatchedSamples {
+		t.Errorf("want ErrMismatchedSamples, got %v", err)
+	}
+	if _, err := PolynomialFit([]float64{1, 2, 3}, []float64{1, 2, 3}, 3); err != ErrSampleSize {
+		t.Errorf("degree >= n: want ErrSampleSize, got %v", err)
+	}
+	if _, err := PolynomialFit([]float64{1, 1, 2, 2}, []float64{1, 2, 3, 4}, 2); err != ErrSampleSize {
+		t.Errorf("too few distinct x: want ErrSampleSize, got %v", err)
+	}
+}
+
+func TestEval(t *testing.T) {
+	if got := Eval(nil, 2); got != 0 {
+		t.Errorf("Eval(nil, 2) = %v, want 0", got)
+	}
+	if got := Eval([]float64{1, 2, 3}, 2); got != 17 {
+		t.Errorf("Eval([1 2 3], 2) = %v, want 17", got)
+	}
+}
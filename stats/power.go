@@ -0,0 +1,99 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// TwoSampleTTestPower returns the power of a two-sided, two-sample
+// t-test (as performed by TwoSampleTTest) with sample sizes n1 and n2
+// and significance level alpha, when the true difference in means is
+// effectSize standard deviations (Cohen's d). That is, it returns the
+// probability that the test rejects the null hypothesis at level
+// alpha given the alternative.
+//
+// This is computed from the noncentral t-distribution by numerical
+// integration, rather than from a normal approximation, so it is
+// accurate even for small samples.
+//
+// If n1 or n2 is less than 2, or alpha is not in (0, 1),
+// TwoSampleTTestPower returns NaN.
+func TwoSampleTTestPower(n1, n2 int, effectSize, alpha float64) float64 {
+	if n1 < 2 || n2 < 2 || !(alpha > 0 && alpha < 1) || math.IsNaN(effectSize) {
+		return nan
+	}
+	fn1, fn2 := float64(n1), float64(n2)
+	dof := fn1 + fn2 - 2
+	// The noncentrality parameter of the t statistic.
+	δ := effectSize * math.Sqrt(fn1*fn2/(fn1+fn2))
+	tc := TDist{dof}.InvCDF(1 - alpha/2)
+	return 1 - noncentralTCDF(tc, dof, δ) + noncentralTCDF(-tc, dof, δ)
+}
+
+// TwoSampleTTestSampleSize returns the smallest per-group sample size
+// n such that a two-sided, two-sample t-test with n observations in
+// each group and significance level alpha has at least the given
+// power to detect a difference in means of effectSize standard
+// deviations. See TwoSampleTTestPower.
+//
+// If effectSize is 0 or NaN, alpha is not in (0, 1), or power is not
+// in (alpha, 1), TwoSampleTTestSampleSize returns -1.
+func TwoSampleTTestSampleSize(effectSize, alpha, power float64) int {
+	if effectSize == 0 || math.IsNaN(effectSize) || !(alpha > 0 && alpha < 1) || !(power > alpha && power < 1) {
+		return -1
+	}
+	ok := func(n int) bool {
+		return TwoSampleTTestPower(n, n, effectSize, alpha) >= power
+	}
+	// Power increases with n, so find an upper bound by doubling
+	// and then bisect.
+	lo, hi := 1, 2
+	for !ok(hi) {
+		if hi > math.MaxInt32/2 {
+			return -1
+		}
+		lo, hi = hi, hi*2
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if ok(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi
+}
+
+// noncentralTCDF returns the CDF at t of the noncentral
+// t-distribution with dof degrees of freedom and noncentrality
+// parameter δ.
+//
+// A noncentral t variate is (Z + δ)/√(V/dof), where Z is standard
+// normal and V is chi-squared with dof degrees of freedom. Hence,
+// conditioning on V = v, the CDF is Φ(t√(v/dof) - δ). This integrates
+// that over the distribution of V.
+func noncentralTCDF(t, dof, δ float64) float64 {
+	if δ == 0 {
+		return TDist{dof}.CDF(t)
+	}
+	chi2 := ChiSquaredDist{dof}
+	f := func(v float64) float64 {
+		return StdNormal.CDF(t*math.Sqrt(v/dof)-δ) * chi2.PDF(v)
+	}
+	// Beyond 20 standard deviations from the mean, the mass of V
+	// is negligible. f is concentrated in a small part of this
+	// range, so integrate it in pieces to keep the adaptive
+	// integration from missing that part entirely.
+	const pieces = 64
+	sd := math.Sqrt(2 * dof)
+	lo, hi := math.Max(0, dof-20*sd), dof+20*sd+40
+	sum := 0.0
+	for i := 0; i < pieces; i++ {
+		a := lo + (hi-lo)*float64(i)/pieces
+		b := lo + (hi-lo)*float64(i+1)/pieces
+		sum += integrateAdaptive(f, a, b, 1e-9/pieces)
+	}
+	return math.Min(sum, 1)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNoncentralTCDF(t *testing.T) {
+	// With δ = 0, this is the central t-distribution.
+	for _, x := range []float64{-3, -1, 0, 0.5, 2} {
+		want := TDist{5}.CDF(x)
+		got := noncentralTCDF(x, 5, 0)
+		if !aeq(got, want) {
+			t.Errorf("noncentralTCDF(%v, 5, 0) = %v, want %v", x, got, want)
+		}
+		// Check the integral itself against the central case
+		// by shifting δ to a negligible value.
+		if got := noncentralTCDF(x, 5, 1e-300); math.Abs(got-want) > 1e-10 {
+			t.Errorf("noncentralTCDF(%v, 5, 1e-300) = %v, want %v", x, got, want)
+		}
+	}
+	// As dof → ∞, this approaches a normal distribution with mean δ.
+	for _, x := range []float64{-1, 1, 2, 3} {
+		want := NormalDist{Mu: 2, Sigma: 1}.CDF(x)
+		if got := noncentralTCDF(x, 1e6, 2); math.Abs(got-want) > 1e-4 {
+			t.Errorf("noncentralTCDF(%v, 1e6, 2) = %v, want ~%v", x, got, want)
+		}
+	}
+}
+
+func TestTwoSampleTTestPower(t *testing.T) {
+	// Reference values from R's power.t.test with strict = TRUE.
+	for _, test := range []struct {
+		n     int
+		d     float64
+		alpha float64
+		want  float64
+	}{
+		{64, 0.5, 0.05, 0.8014596},
+		{20, 1, 0.05, 0.8689528},
+	} {
+		got := TwoSampleTTestPower(test.n, test.n, test.d, test.alpha)
+		if math.Abs(got-test.want) > 1e-6 {
+			t.Errorf("TwoSampleTTestPower(%d, %d, %v, %v) = %v, want %v", test.n, test.n, test.d, test.alpha, got, test.want)
+		}
+	}
+
+	// With no effect, the power is just the significance level.
+	if got := TwoSampleTTestPower(10, 15, 0, 0.05); !aeq(got, 0.05) {
+		t.Errorf("TwoSampleTTestPower(10, 15, 0, 0.05) = %v, want 0.05", got)
+	}
+	// Power is symmetric in the sign of the effect.
+	if a, b := TwoSampleTTestPower(10, 15, 0.7, 0.05), TwoSampleTTestPower(10, 15, -0.7, 0.05); !aeq(a, b) {
+		t.Errorf("TwoSampleTTestPower(10, 15, ±0.7, 0.05) = %v, %v; want equal", a, b)
+	}
+
+	if got := TwoSampleTTestPower(1, 10, 0.5, 0.05); !math.IsNaN(got) {
+		t.Errorf("TwoSampleTTestPower(1, 10, 0.5, 0.05) = %v, want NaN", got)
+	}
+}
+
+func TestTwoSampleTTestSampleSize(t *testing.T) {
+	// Reference values from R's power.t.test, rounded up.
+	for _, test := range []struct {
+		d, alpha, power float64
+		want            int
+	}{
+		{0.5, 0.05, 0.8, 64},
+		{1, 0.05, 0.9, 23},
+	} {
+		got := TwoSampleTTestSampleSize(test.d, test.alpha, test.power)
+		if got != test.want {
+			t.Errorf("TwoSampleTTestSampleSize(%v, %v, %v) = %v, want %v", test.d, test.alpha, test.power, got, test.want)
+		}
+		if p := TwoSampleTTestPower(got-1, got-1, test.d, test.alpha); p >= test.power {
+			t.Errorf("TwoSampleTTestPower(%d, %d, %v, %v) = %v, so sample size %d is not minimal", got-1, got-1, test.d, test.alpha, p, got)
+		}
+	}
+
+	// Small effects need large samples. By the normal
+	// approximation, n ≈ 2((z_{α/2} + z_β)/d)² ≈ 156978.
+	if got := TwoSampleTTestSampleSize(0.01, 0.05, 0.8); math.Abs(float64(got)-156978) > 10 {
+		t.Errorf("TwoSampleTTestSampleSize(0.01, 0.05, 0.8) = %v, want ~156978", got)
+	}
+
+	if got := TwoSampleTTestSampleSize(0, 0.05, 0.8); got != -1 {
+		t.Errorf("TwoSampleTTestSampleSize(0, 0.05, 0.8) = %v, want -1", got)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "sort"
+
+// A TieMethod specifies how Ranks assigns ranks to tied values. The
+// default (zero) value is TieAverage.
+type TieMethod int
+
+//go:generate stringer -type TieMethod
+
+const (
+	// TieAverage assigns each group of tied values the average
+	// of the ranks they span. This is what most rank-based tests
+	// expect. For example, the ranks of [10, 20, 20, 30] are
+	// [1, 2.5, 2.5, 4].
+	TieAverage TieMethod = iota
+
+	// TieMin assigns each group of tied values the lowest of the
+	// ranks they span, as in sports rankings. For example, the
+	// ranks of [10, 20, 20, 30] are [1, 2, 2, 4].
+	TieMin
+
+	// TieMax assigns each group of tied values the highest of
+	// the ranks they span. For example, the ranks of
+	// [10, 20, 20, 30] are [1, 3, 3, 4].
+	TieMax
+
+	// TieDense is like TieMin, but the rank of the next group
+	// is one more than the rank of the previous group, so there
+	// are no gaps. For example, the ranks of [10, 20, 20, 30] are
+	// [1, 2, 2, 3].
+	TieDense
+
+	// TieOrdinal assigns every value a distinct rank, breaking
+	// ties in order of appearance. For example, the ranks of
+	// [10, 20, 20, 30] are [1, 2, 3, 4].
+	TieOrdinal
+)
+
+// Ranks returns the 1-based ranks of the values in xs, from smallest
+// to largest, using ties to assign ranks to tied values.
+func Ranks(xs []float64, ties TieMethod) []float64 {
+	ranks, _ := ranksAndTies(xs, ties)
+	return ranks
+}
+
+// ranksAndTies returns the ranks of xs like Ranks. It also returns
+// the sizes of each group of tied values, in increasing order of
+// value, suitable for passing to tieCorrection.
+func ranksAndTies(xs []float64, method TieMethod) (ranks []float64, ties []int) {
+	idx := make([]int, len(xs))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return xs[idx[i]] < xs[idx[j]] })
+
+	ranks = make([]float64, len(xs))
+	for i := 0; i < len(idx); {
+		j := i + 1
+		for j < len(idx) && xs[idx[j]] == xs[idx[i]] {
+			j++
+		}
+		// Elements i through j-1 span ranks i+1 through j.
+		var rank float64
+		switch method {
+		default:
+			panic("unknown tie method")
+		case TieAverage:
+			rank = float64(i+1+j) / 2
+		case TieMin:
+			rank = float64(i + 1)
+		case TieMax:
+			rank = float64(j)
+		case TieDense:
+			rank = float64(len(ties) + 1)
+		case TieOrdinal:
+			for r, k := range idx[i:j] {
+				ranks[k] = float64(i + 1 + r)
+			}
+		}
+		if method != TieOrdinal {
+			for _, k := range idx[i:j] {
+				ranks[k] = rank
+			}
+		}
+		ties = append(ties, j-i)
+		i = j
+	}
+	return
+}
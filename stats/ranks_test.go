@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "testing"
+
+func TestRanks(t *testing.T) {
+	xs := []float64{30, 10, 20, 40, 20, 10, 20}
+	for _, test := range []struct {
+		method TieMethod
+		want   []float64
+	}{
+		{TieAverage, []float64{6, 1.5, 4, 7, 4, 1.5, 4}},
+		{TieMin, []float64{6, 1, 3, 7, 3, 1, 3}},
+		{TieMax, []float64{6, 2, 5, 7, 5, 2, 5}},
+		{TieDense, []float64{3, 1, 2, 4, 2, 1, 2}},
+		{TieOrdinal, []float64{6, 1, 3, 7, 4, 2, 5}},
+	} {
+		got := Ranks(xs, test.method)
+		if len(got) != len(test.want) {
+			t.Errorf("Ranks(%v, %v) = %v, want %v", xs, test.method, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("Ranks(%v, %v) = %v, want %v", xs, test.method, got, test.want)
+				break
+			}
+		}
+	}
+
+	if got := Ranks(nil, TieAverage); len(got) != 0 {
+		t.Errorf("Ranks(nil) = %v, want []", got)
+	}
+
+	_, ties := ranksAndTies(xs, TieAverage)
+	want := []int{2, 3, 1, 1}
+	if len(ties) != len(want) {
+		t.Fatalf("ranksAndTies(%v) ties = %v, want %v", xs, ties, want)
+	}
+	for i := range ties {
+		if ties[i] != want[i] {
+			t.Fatalf("ranksAndTies(%v) ties = %v, want %v", xs, ties, want)
+		}
+	}
+}
+
+func TestTieMethodString(t *testing.T) {
+	for m, want := range map[TieMethod]string{
+		TieAverage:     "TieAverage",
+		TieMin:         "TieMin",
+		TieMax:         "TieMax",
+		TieDense:       "TieDense",
+		TieOrdinal:     "TieOrdinal",
+		TieOrdinal + 1: "TieMethod(5)",
+	} {
+		if got := m.String(); got != want {
+			t.Errorf("TieMethod(%d).String() = %q, want %q", int(m), got, want)
+		}
+	}
+}
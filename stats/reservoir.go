@@ -0,0 +1,59 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Reservoir maintains a uniform random sample of fixed size from a
+// stream of data of unknown length, using Vitter's Algorithm R.
+// After n values have been added, each of them is in the sample with
+// probability min(1, k/n), where k is the reservoir size.
+//
+// Reservoir should be constructed with NewReservoir.
+type Reservoir struct {
+	// Count is the number of values added.
+	Count uint64
+
+	xs    []float64
+	int63 func(int64) int64
+}
+
+// NewReservoir returns a Reservoir that keeps a sample of up to k
+// values. k must be at least 1.
+//
+// If r is nil, the Reservoir uses the default Source in math/rand.
+func NewReservoir(k int, r *rand.Rand) *Reservoir {
+	if k < 1 {
+		panic(fmt.Sprintf("reservoir size %d < 1", k))
+	}
+	int63 := rand.Int63n
+	if r != nil {
+		int63 = r.Int63n
+	}
+	return &Reservoir{xs: make([]float64, 0, k), int63: int63}
+}
+
+// Add offers x to the reservoir.
+func (s *Reservoir) Add(x float64) {
+	s.Count++
+	if len(s.xs) < cap(s.xs) {
+		s.xs = append(s.xs, x)
+		return
+	}
+	// Replace a random element with probability k/Count.
+	if j := s.int63(int64(s.Count)); j < int64(len(s.xs)) {
+		s.xs[j] = x
+	}
+}
+
+// Sample returns a copy of the current sample. Its length is the
+// smaller of the reservoir size and Count. The order of the values
+// is not meaningful.
+func (s *Reservoir) Sample() []float64 {
+	return append([]float64(nil), s.xs...)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestReservoir(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	// Fewer values than the reservoir size are all kept.
+	s := NewReservoir(5, r)
+	s.Add(1)
+	s.Add(2)
+	if got := s.Sample(); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("Sample() = %v, want [1 2]", got)
+	}
+
+	const k, n, runs = 3, 10, 20000
+	counts := make([]int, n)
+	for run := 0; run < runs; run++ {
+		s := NewReservoir(k, r)
+		for i := 0; i < n; i++ {
+			s.Add(float64(i))
+		}
+		sample := s.Sample()
+		if len(sample) != k {
+			t.Fatalf("len(Sample()) = %d, want %d", len(sample), k)
+		}
+		seen := make(map[float64]bool)
+		for _, x := range sample {
+			if seen[x] {
+				t.Fatalf("Sample() = %v contains duplicates", sample)
+			}
+			seen[x] = true
+			counts[int(x)]++
+		}
+	}
+	// Each element should be included with probability k/n.
+	p := float64(k) / n
+	tol := 5 * math.Sqrt(p*(1-p)/runs)
+	for i, c := range counts {
+		if got := float64(c) / runs; math.Abs(got-p) > tol {
+			t.Errorf("element %d included with frequency %v, want %v ± %v", i, got, p, tol)
+		}
+	}
+}
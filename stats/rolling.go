@@ -0,0 +1,150 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+)
+
+// RollingWindow tracks statistics of the most recent N values of a
+// stream of data. Add takes amortized O(1) time, and all statistics
+// take O(1) time.
+//
+// RollingWindow should be constructed with NewRollingWindow.
+type RollingWindow struct {
+	// buf is a ring buffer of the values in the window. The
+	// oldest value is at buf[next] once the buffer is full.
+	buf  []float64
+	next int
+	// added is the total number of values ever added.
+	added int
+
+	// Online mean and sum of squared deviations of the values
+	// in buf.
+	mean, m2 float64
+
+	// minq and maxq are monotonic deques of the indexes (in
+	// terms of added) of candidate minimum and maximum values.
+	// The values at minq are increasing and the values at maxq
+	// are decreasing, so the front of each is the current
+	// extreme.
+	minq, maxq []int
+}
+
+// NewRollingWindow returns a RollingWindow over the most recent n
+// values. n must be at least 1.
+func NewRollingWindow(n int) *RollingWindow {
+	if n < 1 {
+		panic(fmt.Sprintf("rolling window size %d < 1", n))
+	}
+	return &RollingWindow{buf: make([]float64, 0, n)}
+}
+
+// Len returns the number of values in the window, which is at most
+// the window size.
+func (w *RollingWindow) Len() int {
+	return len(w.buf)
+}
+
+// Add adds x to the window, evicting the oldest value if the window
+// is full.
+func (w *RollingWindow) Add(x float64) {
+	idx := w.added
+	w.added++
+	if len(w.buf) < cap(w.buf) {
+		w.buf = append(w.buf, x)
+		n := float64(len(w.buf))
+		delta := x - w.mean
+		w.mean += delta / n
+		w.m2 += delta * (x - w.mean)
+	} else {
+		old := w.buf[w.next]
+		w.buf[w.next] = x
+		w.next++
+		if w.next == len(w.buf) {
+			w.next = 0
+			// The incremental updates accumulate round-off,
+			// so recompute from scratch once per trip around
+			// the ring. This keeps Add amortized O(1).
+			w.mean, w.m2 = 0, 0
+			for i, y := range w.buf {
+				delta := y - w.mean
+				w.mean += delta / float64(i+1)
+				w.m2 += delta * (y - w.mean)
+			}
+		} else {
+			// Replace old with x.
+			n := float64(len(w.buf))
+			mean := w.mean + (x-old)/n
+			w.m2 += (x - old) * (x - mean + old - w.mean)
+			w.mean = mean
+		}
+	}
+
+	// Evict indexes that have left the window and values that can
+	// no longer be the extreme.
+	first := w.added - len(w.buf)
+	w.minq = pushMonotonic(w.minq, w.buf, idx, first, func(a, b float64) bool { return a >= b })
+	w.maxq = pushMonotonic(w.maxq, w.buf, idx, first, func(a, b float64) bool { return a <= b })
+}
+
+// pushMonotonic adds index idx to the monotonic deque q, removing
+// indexes before first from the front and indexes whose values are
+// dominated by the value at idx from the back.
+func pushMonotonic(q []int, buf []float64, idx, first int, dominated func(a, b float64) bool) []int {
+	x := buf[idx%cap(buf)]
+	for len(q) > 0 && dominated(buf[q[len(q)-1]%cap(buf)], x) {
+		q = q[:len(q)-1]
+	}
+	for len(q) > 0 && q[0] < first {
+		q = q[1:]
+	}
+	return append(q, idx)
+}
+
+// Mean returns the mean of the values in the window, or NaN if the
+// window is empty.
+func (w *RollingWindow) Mean() float64 {
+	if len(w.buf) == 0 {
+		return nan
+	}
+	return w.mean
+}
+
+// Variance returns the sample variance of the values in the window,
+// or NaN if there are fewer than two.
+func (w *RollingWindow) Variance() float64 {
+	if len(w.buf) < 2 {
+		return nan
+	}
+	// Round-off can make m2 slightly negative if the values are
+	// all equal.
+	return math.Max(w.m2, 0) / float64(len(w.buf)-1)
+}
+
+// StdDev returns the sample standard deviation of the values in the
+// window, or NaN if there are fewer than two.
+func (w *RollingWindow) StdDev() float64 {
+	return math.Sqrt(w.Variance())
+}
+
+// Min returns the minimum value in the window, or NaN if the window
+// is empty.
+func (w *RollingWindow) Min() float64 {
+	if len(w.minq) == 0 {
+		return nan
+	}
+	return w.buf[w.minq[0]%cap(w.buf)]
+}
+
+// Max returns the maximum value in the window, or NaN if the window
+// is empty.
+func (w *RollingWindow) Max() float64 {
+	if len(w.maxq) == 0 {
+		return nan
+	}
+	return w.buf[w.maxq[0]%cap(w.buf)]
+}
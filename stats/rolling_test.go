@@ -0,0 +1,59 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestRollingWindow(t *testing.T) {
+	check := func(what string, i int, got, want float64) {
+		t.Helper()
+		if math.IsNaN(want) && math.IsNaN(got) {
+			return
+		}
+		if math.Abs(got-want) > 1e-9*math.Max(1, math.Abs(want)) {
+			t.Errorf("after %d values: %s = %v, want %v", i, what, got, want)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 64} {
+		w := NewRollingWindow(n)
+		if !math.IsNaN(w.Mean()) || !math.IsNaN(w.Min()) || !math.IsNaN(w.Max()) {
+			t.Errorf("empty window: Mean=%v Min=%v Max=%v, want NaN", w.Mean(), w.Min(), w.Max())
+		}
+		var xs []float64
+		for i := 0; i < 1000; i++ {
+			x := 1000 + r.NormFloat64()
+			if i%50 < 10 {
+				// Runs of repeated values.
+				x = 1000
+			}
+			w.Add(x)
+			xs = append(xs, x)
+			win := xs[maxint(0, len(xs)-n):]
+			if w.Len() != len(win) {
+				t.Fatalf("after %d values: Len = %d, want %d", i+1, w.Len(), len(win))
+			}
+			s := Sample{Xs: win}
+			min, max := s.Bounds()
+			check("Mean", i+1, w.Mean(), s.Mean())
+			want := nan
+			if len(win) >= 2 {
+				want = s.Variance()
+			}
+			check("Variance", i+1, w.Variance(), want)
+			if got := w.Min(); got != min {
+				t.Errorf("n=%d after %d values: Min = %v, want %v", n, i+1, got, min)
+			}
+			if got := w.Max(); got != max {
+				t.Errorf("n=%d after %d values: Max = %v, want %v", n, i+1, got, max)
+			}
+		}
+	}
+}
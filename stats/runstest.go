@@ -0,0 +1,92 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// A RunsResult is the result of a Wald-Wolfowitz runs test.
+type RunsResult struct {
+	// Threshold is the value around which the series was
+	// dichotomized.
+	Threshold float64
+
+	// NAbove and NBelow are the numbers of values above and below
+	// Threshold. Values equal to Threshold are not counted.
+	NAbove, NBelow int
+
+	// Runs is the number of maximal runs of consecutive values on
+	// the same side of Threshold.
+	Runs int
+
+	// Z is the normal approximation statistic for Runs. It is
+	// positive if there are more runs than expected, suggesting
+	// oscillation, and negative if there are fewer, suggesting
+	// clustering.
+	Z float64
+
+	// P is the two-sided p-value of the null hypothesis that the
+	// series is random.
+	P float64
+}
+
+// RunsTest performs a Wald-Wolfowitz runs test for randomness of the
+// series xs, dichotomized around its median. See RunsTestThreshold.
+func RunsTest(xs []float64) (RunsResult, error) {
+	median := Sample{Xs: xs}.Quantile(0.5)
+	return RunsTestThreshold(xs, median)
+}
+
+// RunsTestThreshold performs a Wald-Wolfowitz runs test for
+// randomness of the series xs, dichotomized around threshold. This
+// tests the null hypothesis that the order of values above and below
+// threshold is random by comparing the number of runs of consecutive
+// values on the same side against its distribution under random
+// ordering, using a normal approximation. For example, pass/fail
+// outcomes coded as 0 and 1 can be tested with a threshold of 0.5.
+//
+// Values exactly equal to threshold are discarded, as in R's
+// randtests package, so they neither start nor end a run.
+//
+// This can fail with ErrSampleSize if, after discarding values equal
+// to threshold, there are no values on one side of threshold, or
+// only one on each side.
+func RunsTestThreshold(xs []float64, threshold float64) (RunsResult, error) {
+	var above, below, runs int
+	prev := 0
+	for _, x := range xs {
+		side := 0
+		if x > threshold {
+			side = 1
+			above++
+		} else if x < threshold {
+			side = -1
+			below++
+		} else {
+			continue
+		}
+		if side != prev {
+			runs++
+			prev = side
+		}
+	}
+	if above == 0 || below == 0 || above+below == 2 {
+		return RunsResult{}, ErrSampleSize
+	}
+
+	n1, n2 := float64(above), float64(below)
+	n := n1 + n2
+	mean := 2*n1*n2/n + 1
+	v := 2 * n1 * n2 * (2*n1*n2 - n) / (n * n * (n - 1))
+	z := (float64(runs) - mean) / math.Sqrt(v)
+	p := 2 * StdNormal.CDF(-math.Abs(z))
+	return RunsResult{
+		Threshold: threshold,
+		NAbove:    above,
+		NBelow:    below,
+		Runs:      runs,
+		Z:         z,
+		P:         p,
+	}, nil
+}
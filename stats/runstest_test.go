@@ -0,0 +1,58 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "testing"
+
+func TestRunsTest(t *testing.T) {
+	check := func(xs []float64, res RunsResult) {
+		t.Helper()
+		got, err := RunsTest(xs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Threshold != res.Threshold || got.NAbove != res.NAbove || got.NBelow != res.NBelow ||
+			got.Runs != res.Runs || !aeq(got.Z, res.Z) || !aeq(got.P, res.P) {
+			t.Errorf("RunsTest(%v) = %+v, want %+v", xs, got, res)
+		}
+	}
+
+	// An alternating series has too many runs.
+	alt := make([]float64, 20)
+	sorted := make([]float64, 20)
+	for i := range alt {
+		alt[i] = float64(1 + i%2)
+		sorted[i] = float64(i + 1)
+	}
+	check(alt, RunsResult{1.5, 10, 10, 20, 4.135214625627066, 3.5462304914688456e-05})
+
+	// A sorted series has too few.
+	check(sorted, RunsResult{10.5, 10, 10, 2, -4.135214625627066, 3.5462304914688456e-05})
+
+	// The value equal to the median is discarded, leaving runs
+	// [3 1 1] [5 9] [2] [6 5].
+	check([]float64{3, 1, 4, 1, 5, 9, 2, 6, 5}, RunsResult{4, 4, 4, 4, -0.7637626158259734, 0.445008718746736})
+}
+
+func TestRunsTestThreshold(t *testing.T) {
+	// Pass/fail outcomes, where the median is one of the values.
+	outcomes := []float64{1, 1, 1, 1, 0, 0, 1, 1, 1, 1, 1, 0, 1, 1, 1, 1}
+	if _, err := RunsTest(outcomes); err != ErrSampleSize {
+		t.Errorf("RunsTest of outcomes: want ErrSampleSize, got %v", err)
+	}
+	res, err := RunsTestThreshold(outcomes, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.NAbove != 13 || res.NBelow != 3 || res.Runs != 5 {
+		t.Errorf("RunsTestThreshold(%v, 0.5) = %+v, want 13 above, 3 below, 5 runs", outcomes, res)
+	}
+
+	for _, xs := range [][]float64{nil, {1, 2}, {1, 1, 1}, {2, 3, 4}} {
+		if _, err := RunsTestThreshold(xs, 1.5); err != ErrSampleSize {
+			t.Errorf("RunsTestThreshold(%v, 1.5): want ErrSampleSize, got %v", xs, err)
+		}
+	}
+}
@@ -113,14 +113,7 @@ func (s Sample) Weight() float64 {
 
 // Mean returns the arithmetic mean of xs.
 func Mean(xs []float64) float64 {
-	if len(xs) == 0 {
-		return math.NaN()
-	}
-	m := 0.0
-	for i, x := range xs {
-		m += (x - m) / float64(i+1)
-	}
-	return m
+	return MeanG(xs)
 }
 
 // Mean returns the arithmetic mean of the Sample.
@@ -176,7 +169,8 @@ func (s Sample) MeanCI(confidence float64) (mean, lo, hi float64) {
 	panic("Weighted MeanCI not implemented")
 }
 
-// GeoMean returns the geometric mean of xs. xs must be positive.
+// GeoMean returns the geometric mean of xs. xs must be positive; if
+// any value is <= 0, GeoMean returns NaN.
 func GeoMean(xs []float64) float64 {
 	if len(xs) == 0 {
 		return math.NaN()
@@ -193,7 +187,10 @@ func GeoMean(xs []float64) float64 {
 }
 
 // GeoMean returns the geometric mean of the Sample. All samples
-// values must be positive.
+// values must be positive; if any value with non-zero weight is <= 0,
+// GeoMean returns NaN.
+//
+// If the Sample is weighted, this is exp(Σ w_i log x_i / Σ w_i).
 func (s Sample) GeoMean() float64 {
 	if len(s.Xs) == 0 || s.Weights == nil {
 		return GeoMean(s.Xs)
@@ -202,32 +199,71 @@ func (s Sample) GeoMean() float64 {
 	m, wsum := 0.0, 0.0
 	for i, x := range s.Xs {
 		w := s.Weights[i]
+		if w == 0 {
+			continue
+		} else if x <= 0 {
+			return math.NaN()
+		}
 		wsum += w
 		lx := math.Log(x)
 		m += (lx - m) * w / wsum
 	}
+	if wsum == 0 {
+		return math.NaN()
+	}
 	return math.Exp(m)
 }
 
-// Variance returns the sample variance of xs.
-func Variance(xs []float64) float64 {
+// HarmonicMean returns the harmonic mean of xs, n / Σ 1/x_i. This
+// is the appropriate mean for rates, such as averaging speeds over
+// equal distances. xs must be positive; if any value is <= 0,
+// HarmonicMean returns NaN.
+func HarmonicMean(xs []float64) float64 {
 	if len(xs) == 0 {
 		return math.NaN()
-	} else if len(xs) <= 1 {
-		return 0
 	}
+	sum := 0.0
+	for _, x := range xs {
+		if x <= 0 {
+			return math.NaN()
+		}
+		sum += 1 / x
+	}
+	return float64(len(xs)) / sum
+}
 
-	// Based on Wikipedia's presentation of Welford 1962
-	// (http://en.wikipedia.org/wiki/Algorithms_for_calculating_variance#Online_algorithm).
-	// This is more numerically stable than the standard two-pass
-	// formula and not prone to massive cancellation.
-	mean, M2 := 0.0, 0.0
-	for n, x := range xs {
-		delta := x - mean
-		mean += delta / float64(n+1)
-		M2 += delta * (x - mean)
+// HarmonicMean returns the harmonic mean of the Sample. All sample
+// values must be positive; if any value with non-zero weight is <= 0,
+// HarmonicMean returns NaN.
+//
+// If the Sample is weighted, this is Σ w_i / Σ (w_i / x_i). For
+// example, if x_i are speeds, this is the average speed over a trip
+// in which distance w_i is traveled at speed x_i.
+func (s Sample) HarmonicMean() float64 {
+	if len(s.Xs) == 0 || s.Weights == nil {
+		return HarmonicMean(s.Xs)
+	}
+
+	sum, wsum := 0.0, 0.0
+	for i, x := range s.Xs {
+		w := s.Weights[i]
+		if w == 0 {
+			continue
+		} else if x <= 0 {
+			return math.NaN()
+		}
+		sum += w / x
+		wsum += w
+	}
+	if wsum == 0 {
+		return math.NaN()
 	}
-	return M2 / float64(len(xs)-1)
+	return wsum / sum
+}
+
+// Variance returns the sample variance of xs.
+func Variance(xs []float64) float64 {
+	return VarianceG(xs)
 }
 
 func (s Sample) Variance() float64 {
@@ -240,7 +276,34 @@ func (s Sample) Variance() float64 {
 
 // StdDev returns the sample standard deviation of xs.
 func StdDev(xs []float64) float64 {
-	return math.Sqrt(Variance(xs))
+	return StdDevG(xs)
+}
+
+// MeanVarMinMax returns the mean, sample variance, minimum, and
+// maximum of xs in a single pass without allocating. The results are
+// identical to those of Mean, Variance, and Bounds.
+func MeanVarMinMax(xs []float64) (mean, variance, min, max float64) {
+	if len(xs) == 0 {
+		return math.NaN(), math.NaN(), math.NaN(), math.NaN()
+	}
+	// Welford's algorithm, as in Variance.
+	M2 := 0.0
+	min, max = xs[0], xs[0]
+	for n, x := range xs {
+		delta := x - mean
+		mean += delta / float64(n+1)
+		M2 += delta * (x - mean)
+		if x < min {
+			min = x
+		}
+		if x > max {
+			max = x
+		}
+	}
+	if len(xs) > 1 {
+		variance = M2 / float64(len(xs)-1)
+	}
+	return
 }
 
 // StdDev returns the sample standard deviation of the Sample.
@@ -252,9 +315,144 @@ func (s Sample) StdDev() float64 {
 	panic("Weighted StdDev not implemented")
 }
 
+// centralMoments returns the second, third, and fourth central
+// moments of xs, normalized by len(xs).
+func centralMoments(xs []float64) (m2, m3, m4 float64) {
+	mean := Mean(xs)
+	for _, x := range xs {
+		d := x - mean
+		d2 := d * d
+		m2 += d2
+		m3 += d2 * d
+		m4 += d2 * d2
+	}
+	n := float64(len(xs))
+	return m2 / n, m3 / n, m4 / n
+}
+
+// Skewness returns the bias-corrected sample skewness of xs,
+//
+//	G1 = √(n(n-1))/(n-2) · m3/m2^(3/2)
+//
+// where m2 and m3 are the second and third central moments. This is
+// the estimator used by most statistical packages, including Excel's
+// SKEW and SAS. A symmetric sample has skewness 0.
+//
+// If len(xs) < 3 or all values are equal, this returns NaN.
+func Skewness(xs []float64) float64 {
+	n := float64(len(xs))
+	if n < 3 {
+		return math.NaN()
+	}
+	m2, m3, _ := centralMoments(xs)
+	if m2 == 0 {
+		return math.NaN()
+	}
+	g1 := m3 / (m2 * math.Sqrt(m2))
+	return g1 * math.Sqrt(n*(n-1)) / (n - 2)
+}
+
+// Skewness returns the bias-corrected sample skewness of the Sample.
+// See the Skewness function.
+func (s Sample) Skewness() float64 {
+	if len(s.Xs) == 0 || s.Weights == nil {
+		return Skewness(s.Xs)
+	}
+	// TODO: Weighted skewness, once weighted Variance is
+	// implemented.
+	panic("Weighted Skewness not implemented")
+}
+
+// Kurtosis returns the bias-corrected sample excess kurtosis of xs,
+//
+//	G2 = (n-1)/((n-2)(n-3)) · ((n+1) g2 + 6)
+//
+// where g2 = m4/m2² - 3 and m2 and m4 are the second and fourth
+// central moments. This is the estimator used by Excel's KURT and
+// SAS. A sample from a normal distribution has an expected excess
+// kurtosis of 0.
+//
+// If len(xs) < 4 or all values are equal, this returns NaN.
+func Kurtosis(xs []float64) float64 {
+	n := float64(len(xs))
+	if n < 4 {
+		return math.NaN()
+	}
+	m2, _, m4 := centralMoments(xs)
+	if m2 == 0 {
+		return math.NaN()
+	}
+	g2 := m4/(m2*m2) - 3
+	return (n - 1) / ((n - 2) * (n - 3)) * ((n+1)*g2 + 6)
+}
+
+// Kurtosis returns the bias-corrected sample excess kurtosis of the
+// Sample. See the Kurtosis function.
+func (s Sample) Kurtosis() float64 {
+	if len(s.Xs) == 0 || s.Weights == nil {
+		return Kurtosis(s.Xs)
+	}
+	// TODO: Weighted kurtosis, once weighted Variance is
+	// implemented.
+	panic("Weighted Kurtosis not implemented")
+}
+
+// A QuantileMethod specifies how Sample.QuantileMethod chooses or
+// interpolates between sample values. The methods are those of
+// Hyndman and Fan (1996) and NumPy's percentile function.
+//
+// Except for QuantileR8, each method is defined in terms of the
+// "virtual index" h = (n-1)q of the q'th quantile in the sorted
+// sample x_0, ..., x_(n-1), and the values x_⌊h⌋ and x_⌈h⌉ on
+// either side of it.
+type QuantileMethod int
+
+//go:generate stringer -type QuantileMethod
+
+const (
+	// QuantileR8 linearly interpolates at virtual index
+	// (n+1/3)q - 2/3. This is method R8 from Hyndman and Fan
+	// and is the default used by Quantile. Its results are
+	// approximately median-unbiased regardless of the
+	// distribution.
+	QuantileR8 QuantileMethod = iota
+
+	// QuantileLinear linearly interpolates between x_⌊h⌋ and
+	// x_⌈h⌉. This is method R7 from Hyndman and Fan, and the
+	// default of NumPy and R.
+	QuantileLinear
+
+	// QuantileLower returns x_⌊h⌋.
+	QuantileLower
+
+	// QuantileHigher returns x_⌈h⌉.
+	QuantileHigher
+
+	// QuantileNearest returns whichever of x_⌊h⌋ and x_⌈h⌉ is
+	// nearer to h. If h is exactly halfway between them, it
+	// returns the one with an even index, like NumPy.
+	QuantileNearest
+
+	// QuantileMidpoint returns (x_⌊h⌋ + x_⌈h⌉) / 2.
+	QuantileMidpoint
+)
+
 // Quantile returns the sample value X at which q*weight of the sample
-// is <= X. This uses interpolation method R8 from Hyndman and Fan
-// (1996).
+// is <= X. For unweighted samples, this uses interpolation method R8
+// from Hyndman and Fan (1996). This is equivalent to
+// s.QuantileMethod(q, QuantileR8).
+//
+// For weighted samples, this uses a weighted generalization of
+// Hyndman and Fan's method R7 (NumPy's default "linear" method). The
+// i'th smallest value x_i with non-zero weight w_i is placed at
+// cumulative probability
+//
+//	p_i = (S_i - w_i) / (S - w_i)
+//
+// where S_i is the total weight of x_1 through x_i and S is the
+// total weight, and Quantile linearly interpolates between these
+// points. The smallest value is always at 0 and the largest at 1.
+// If all weights are equal, this is exactly R7.
 //
 // q will be capped to the range [0, 1]. If len(xs) == 0 or all
 // weights are 0, returns NaN.
@@ -267,6 +465,25 @@ func (s Sample) StdDev() float64 {
 //
 // This is constant time if s.Sorted and s.Weights == nil.
 func (s Sample) Quantile(q float64) float64 {
+	return s.QuantileMethod(q, QuantileR8)
+}
+
+// QuantileMethod returns the q'th quantile of the Sample using the
+// given method to choose or interpolate between sample values.
+//
+// For weighted samples, the virtual index h is instead the
+// (fractional) index at which q falls among the cumulative
+// probabilities p_i described in Quantile. QuantileR8 is not defined
+// for weighted samples and is treated as QuantileLinear.
+//
+// q will be capped to the range [0, 1]. If len(xs) == 0 or all
+// weights are 0, returns NaN.
+//
+// This is constant time if s.Sorted and s.Weights == nil.
+func (s Sample) QuantileMethod(q float64, method QuantileMethod) float64 {
+	if method < QuantileR8 || method > QuantileMidpoint {
+		panic("unknown quantile method")
+	}
 	if len(s.Xs) == 0 {
 		return math.NaN()
 	} else if q <= 0 {
@@ -282,33 +499,89 @@ func (s Sample) Quantile(q float64) float64 {
 		s = *s.Copy().Sort()
 	}
 
+	// Find the values xs[i] and xs[i+1] that bracket the
+	// quantile, and the fraction of the way between them.
+	xs := s.Xs
+	var i int
+	var frac float64
 	if s.Weights == nil {
-		N := float64(len(s.Xs))
-		//n := q * (N + 1) // R6
-		n := 1/3.0 + q*(N+1/3.0) // R8
-		kf, frac := math.Modf(n)
-		k := int(kf)
-		if k <= 0 {
-			return s.Xs[0]
-		} else if k >= len(s.Xs) {
-			return s.Xs[len(s.Xs)-1]
+		N := float64(len(xs))
+		if method == QuantileR8 {
+			// n is the 1-based virtual index.
+			//n := q * (N + 1) // R6
+			n := 1/3.0 + q*(N+1/3.0) // R8
+			kf, f := math.Modf(n)
+			i, frac = int(kf)-1, f
+			if i < 0 {
+				i, frac = 0, 0
+			} else if i >= len(xs)-1 {
+				i, frac = len(xs)-1, 0
+			}
+		} else {
+			kf, f := math.Modf(q * (N - 1)) // R7
+			i, frac = int(kf), f
 		}
-		return s.Xs[k-1] + frac*(s.Xs[k]-s.Xs[k-1])
 	} else {
-		// TODO(austin): Implement interpolation
-
-		target := s.Weight() * q
+		// Zero-weight values don't participate in
+		// interpolation.
+		var ws []float64
+		xs = nil
+		total := 0.0
+		for i, w := range s.Weights {
+			if w > 0 {
+				xs = append(xs, s.Xs[i])
+				ws = append(ws, w)
+				total += w
+			}
+		}
+		if len(xs) == 0 {
+			return math.NaN()
+		}
 
 		// TODO(austin) If we had cumulative weights, we could
 		// do this in log time.
-		for i, weight := range s.Weights {
-			target -= weight
-			if target < 0 {
-				return s.Xs[i]
+		i = len(xs) - 1
+		prevP, cum := 0.0, ws[0]
+		for j := 1; j < len(xs); j++ {
+			p := cum / (total - ws[j])
+			if q == p {
+				i = j
+				break
+			} else if q < p {
+				// p > prevP, since q > prevP.
+				i, frac = j-1, (q-prevP)/(p-prevP)
+				break
 			}
+			prevP = p
+			cum += ws[j]
+		}
+	}
+
+	if frac == 0 || i+1 >= len(xs) {
+		return xs[i]
+	}
+	lo, hi := xs[i], xs[i+1]
+	switch method {
+	case QuantileLower:
+		return lo
+	case QuantileHigher:
+		return hi
+	case QuantileNearest:
+		if frac > 0.5 || (frac == 0.5 && i%2 == 1) {
+			return hi
 		}
-		return s.Xs[len(s.Xs)-1]
+		return lo
+	case QuantileMidpoint:
+		return (lo + hi) / 2
 	}
+	return lo + frac*(hi-lo)
+}
+
+// WeightedMedian returns the median of the Sample, taking into account
+// its weights. This is the same as Quantile(0.5); see Quantile for
+// how weighted values are interpolated.
+func (s Sample) WeightedMedian() float64 {
+	return s.Quantile(0.5)
 }
 
 // IQR returns the interquartile range of the Sample.
@@ -321,6 +594,200 @@ func (s Sample) IQR() float64 {
 	return s.Quantile(0.75) - s.Quantile(0.25)
 }
 
+// TrimmedMean returns the mean of the Sample after discarding the
+// lowest and highest frac fraction of its values. TrimmedMean(0) is
+// the mean, and TrimmedMean(0.5) (or any larger frac) is the median.
+//
+// If the Sample is unweighted, this discards floor(frac*len(s.Xs))
+// values from each end, like R's mean(x, trim=frac). If it is
+// weighted, it discards frac of the total weight from each end,
+// counting a fraction of the weight of a value that straddles a cut.
+//
+// If len(s.Xs) == 0, all weights are 0, or frac < 0, returns NaN.
+//
+// This is linear time if s.Sorted.
+func (s Sample) TrimmedMean(frac float64) float64 {
+	if len(s.Xs) == 0 || !(frac >= 0) {
+		return math.NaN()
+	} else if frac == 0 {
+		return s.Mean()
+	} else if frac >= 0.5 {
+		return s.Quantile(0.5)
+	}
+
+	if !s.Sorted {
+		s = *s.Copy().Sort()
+	}
+
+	if s.Weights == nil {
+		k := int(frac * float64(len(s.Xs)))
+		return Mean(s.Xs[k : len(s.Xs)-k])
+	}
+
+	// Sum the weight of each value that lies within the central
+	// [lo, hi] range of cumulative weight.
+	total := s.Weight()
+	lo, hi := frac*total, (1-frac)*total
+	sum, wsum, cum := 0.0, 0.0, 0.0
+	for i, x := range s.Xs {
+		w := s.Weights[i]
+		a, b := math.Max(cum, lo), math.Min(cum+w, hi)
+		if b > a {
+			sum += x * (b - a)
+			wsum += b - a
+		}
+		cum += w
+	}
+	return sum / wsum
+}
+
+// Winsorize returns a copy of the Sample in which values in the
+// lowest and highest frac fraction of the Sample are clamped to the
+// most extreme values that remain. Unlike trimming, this preserves
+// the size of the Sample. frac is capped to the range [0, 0.5].
+//
+// If the Sample is unweighted, this clamps floor(frac*len(s.Xs))
+// values at each end. If it is weighted, it clamps values to those
+// at frac and 1-frac of the total cumulative weight.
+//
+// The returned Sample has the same order and weights as s. It shares
+// no data with s.
+func (s Sample) Winsorize(frac float64) Sample {
+	out := *s.Copy()
+	if len(s.Xs) == 0 || !(frac > 0) {
+		return out
+	}
+	frac = math.Min(frac, 0.5)
+
+	sorted := s
+	if !s.Sorted {
+		sorted = *s.Copy().Sort()
+	}
+	var lo, hi float64
+	if sorted.Weights == nil {
+		n := len(sorted.Xs)
+		k := minint(int(frac*float64(n)), (n-1)/2)
+		lo, hi = sorted.Xs[k], sorted.Xs[n-1-k]
+	} else {
+		total := sorted.Weight()
+		if total == 0 {
+			return out
+		}
+		loCut, hiCut := frac*total, (1-frac)*total
+		lo, hi = math.NaN(), math.NaN()
+		cum := 0.0
+		for i, x := range sorted.Xs {
+			w := sorted.Weights[i]
+			if math.IsNaN(lo) && cum+w > loCut {
+				lo = x
+			}
+			if w > 0 && cum < hiCut {
+				hi = x
+			}
+			cum += w
+		}
+	}
+
+	for i, x := range out.Xs {
+		if x < lo {
+			out.Xs[i] = lo
+		} else if x > hi {
+			out.Xs[i] = hi
+		}
+	}
+	return out
+}
+
+// WinsorizedMean returns the mean of the Sample after clamping the
+// lowest and highest frac fraction of its values. See Winsorize.
+func (s Sample) WinsorizedMean(frac float64) float64 {
+	return s.Winsorize(frac).Mean()
+}
+
+// Mode returns the most frequent value in the Sample and the number
+// of times it occurs. If several values are equally frequent, Mode
+// returns the smallest. This is intended for discrete data; for
+// continuous data, see ModeBinned.
+//
+// If the Sample is weighted, Mode returns the value with the greatest
+// total weight. Values with zero weight are ignored.
+//
+// If the Sample is empty, Mode returns NaN, 0.
+func (s Sample) Mode() (float64, int) {
+	return s.mode(func(x float64) float64 { return x })
+}
+
+// ModeBinned returns the center of the most frequent bin of the
+// Sample and the number of values in that bin, where the bins are the
+// intervals [k*binWidth, (k+1)*binWidth) for integer k. If several
+// bins are equally frequent, ModeBinned returns the lowest.
+//
+// Weights are treated as in Mode.
+//
+// If the Sample is empty or binWidth <= 0, ModeBinned returns NaN, 0.
+func (s Sample) ModeBinned(binWidth float64) (float64, int) {
+	if !(binWidth > 0) {
+		return math.NaN(), 0
+	}
+	k, n := s.mode(func(x float64) float64 { return math.Floor(x / binWidth) })
+	return (k + 0.5) * binWidth, n
+}
+
+// mode returns the most frequent value of key(x) over the Sample
+// and its number of occurrences. key must be monotonic.
+func (s Sample) mode(key func(x float64) float64) (float64, int) {
+	if !s.Sorted {
+		s = *s.Copy().Sort()
+	}
+	best, bestN, bestW := math.NaN(), 0, 0.0
+	cur, curN, curW := math.NaN(), 0, 0.0
+	for i, x := range s.Xs {
+		w := 1.0
+		if s.Weights != nil {
+			w = s.Weights[i]
+			if w == 0 {
+				continue
+			}
+		}
+		if k := key(x); k != cur {
+			cur, curN, curW = k, 0, 0
+		}
+		curN++
+		curW += w
+		if curW > bestW {
+			best, bestN, bestW = cur, curN, curW
+		}
+	}
+	return best, bestN
+}
+
+// MAD returns the median absolute deviation of the Sample: the median
+// of the absolute deviations of each value from the median of the
+// Sample. This is a robust measure of spread that is insensitive to
+// outliers.
+//
+// If the Sample is weighted, each deviation has the weight of its
+// value.
+func (s Sample) MAD() float64 {
+	if len(s.Xs) == 0 {
+		return math.NaN()
+	}
+	med := s.Quantile(0.5)
+	dev := make([]float64, len(s.Xs))
+	for i, x := range s.Xs {
+		dev[i] = math.Abs(x - med)
+	}
+	return Sample{Xs: dev, Weights: s.Weights}.Quantile(0.5)
+}
+
+// MADScaled returns the median absolute deviation of the Sample
+// scaled by 1.4826, which makes it a consistent estimator of the
+// standard deviation for normally distributed data. The constant
+// is 1/Φ⁻¹(3/4).
+func (s Sample) MADScaled() float64 {
+	return 1.4826 * s.MAD()
+}
+
 type sampleSorter struct {
 	xs      []float64
 	weights []float64
@@ -6,6 +6,7 @@ package stats
 
 import (
 	"math"
+	"math/rand"
 	"testing"
 )
 
@@ -23,6 +24,61 @@ func TestSampleQuantile(t *testing.T) {
 	})
 }
 
+func TestSampleWeightedQuantile(t *testing.T) {
+	// With equal weights, this is R7 (NumPy's "linear" method).
+	// For example, numpy.percentile([15, 20, 35, 40, 50], 30) is 23.
+	s := Sample{Xs: []float64{15, 20, 35, 40, 50}, Weights: []float64{2, 2, 2, 2, 2}}
+	testFunc(t, "equal-weight Quantile", s.Quantile, map[float64]float64{
+		-1:   15,
+		0:    15,
+		0.05: 16,
+		0.25: 20,
+		0.30: 23,
+		0.5:  35,
+		0.95: 48,
+		1:    50,
+		2:    50,
+	})
+
+	// With weights [1, 3, 1, 1], the total weight is 6 and the
+	// values are placed at p = 0/5, 1/3, 4/5, 5/5.
+	s = Sample{Xs: []float64{3, 1, 2, 4}, Weights: []float64{1, 1, 3, 1}}
+	testFunc(t, "weighted Quantile", s.Quantile, map[float64]float64{
+		0:       1,
+		1.0 / 6: 1.5,
+		1.0 / 3: 2,
+		0.5:     2 + 5.0/14,
+		0.8:     3,
+		0.9:     3.5,
+		1:       4,
+	})
+	if got := s.WeightedMedian(); !aeq(got, 2+5.0/14) {
+		t.Errorf("WeightedMedian() = %v, want %v", got, 2+5.0/14)
+	}
+
+	// Zero-weight values are ignored entirely.
+	s = Sample{Xs: []float64{0, 1, 2, 100}, Weights: []float64{0, 1, 1, 0}}
+	testFunc(t, "zero-weight Quantile", s.Quantile, map[float64]float64{
+		0:    1,
+		0.25: 1.25,
+		0.5:  1.5,
+		1:    2,
+	})
+	s = Sample{Xs: []float64{5, 7}, Weights: []float64{0, 3}}
+	if got := s.Quantile(0.3); got != 7 {
+		t.Errorf("single-weight Quantile(0.3) = %v, want 7", got)
+	}
+	s = Sample{Xs: []float64{5, 7}, Weights: []float64{0, 0}}
+	if got := s.Quantile(0.3); !math.IsNaN(got) {
+		t.Errorf("zero-weight Quantile(0.3) = %v, want NaN", got)
+	}
+
+	// The unweighted median is unaffected.
+	if got := (Sample{Xs: []float64{4, 1, 3, 2}}).WeightedMedian(); got != 2.5 {
+		t.Errorf("unweighted WeightedMedian() = %v, want 2.5", got)
+	}
+}
+
 func TestMeanCI(t *testing.T) {
 	var xs []float64
 	naneq := func(a, b float64) bool {
@@ -52,3 +108,355 @@ func TestMeanCI(t *testing.T) {
 	check(0.95, math.NaN(), math.NaN(), math.NaN())
 	check(1, math.NaN(), math.NaN(), math.NaN())
 }
+
+func TestSampleTrimmedMean(t *testing.T) {
+	// 1 through 18, plus two extreme outliers.
+	xs := []float64{1e7}
+	for i := 1.0; i <= 18; i++ {
+		xs = append(xs, i)
+	}
+	xs = append(xs, 1e6)
+	orig := append([]float64(nil), xs...)
+	s := Sample{Xs: xs}
+	// With frac=0.1, this drops 2 values from each end, including
+	// both outliers.
+	if got := s.TrimmedMean(0.1); got != 10.5 {
+		t.Errorf("TrimmedMean(0.1) = %v, want 10.5", got)
+	}
+	for i := range xs {
+		if xs[i] != orig[i] {
+			t.Fatalf("TrimmedMean modified sample")
+		}
+	}
+	s.Sort()
+	testFunc(t, "TrimmedMean", s.TrimmedMean, map[float64]float64{
+		-0.1: nan,
+		0:    s.Mean(),
+		0.1:  10.5,
+		0.12: 10.5,
+		0.45: 10.5,
+		0.5:  10.5,
+		1:    10.5,
+	})
+
+	// Weighted trimming should match trimming the sample with the
+	// weights expanded into repeated values.
+	ws := Sample{Xs: []float64{4, 1, 100, 3, 2}, Weights: []float64{1, 1, 1, 2, 1}}
+	expanded := Sample{Xs: []float64{1, 2, 3, 3, 4, 100}}
+	if got, want := ws.TrimmedMean(1.0/6), expanded.TrimmedMean(1.0/6); !aeq(got, want) || want != 3 {
+		t.Errorf("weighted TrimmedMean(1/6) = %v, want %v (and 3)", got, want)
+	}
+	// Cutting through the middle of a weight counts only part of
+	// it. Trimming 1.5 units of weight from each end leaves half
+	// of 2, both 3s, and half of 4.
+	if got := ws.TrimmedMean(0.25); !aeq(got, 3) {
+		t.Errorf("weighted TrimmedMean(0.25) = %v, want 3", got)
+	}
+	zero := Sample{Xs: []float64{1, 2}, Weights: []float64{0, 0}}
+	if got := zero.TrimmedMean(0.1); !math.IsNaN(got) {
+		t.Errorf("TrimmedMean with zero weights = %v, want NaN", got)
+	}
+}
+
+func TestSampleWinsorize(t *testing.T) {
+	s := Sample{Xs: []float64{13, 1, 50, 2, 3, 1, 8, 3, 4, 2, 5, 21}}
+	w := s.Winsorize(0.1)
+	want := []float64{13, 1, 21, 2, 3, 1, 8, 3, 4, 2, 5, 21}
+	for i := range want {
+		if w.Xs[i] != want[i] {
+			t.Fatalf("Winsorize(0.1) = %v, want %v", w.Xs, want)
+		}
+	}
+	if s.Xs[2] != 50 {
+		t.Errorf("Winsorize modified sample")
+	}
+	if got := s.WinsorizedMean(0.1); !aeq(got, 7) {
+		t.Errorf("WinsorizedMean(0.1) = %v, want 7", got)
+	}
+
+	// On right-skewed data, the Winsorized mean lies between the
+	// trimmed mean and the mean.
+	for _, frac := range []float64{0.1, 0.2, 0.3} {
+		tm, wm, m := s.TrimmedMean(frac), s.WinsorizedMean(frac), s.Mean()
+		if !(tm <= wm && wm <= m) {
+			t.Errorf("frac %v: want TrimmedMean %v <= WinsorizedMean %v <= Mean %v", frac, tm, wm, m)
+		}
+	}
+
+	// Winsorizing at 0.5 clamps everything to the median.
+	odd := Sample{Xs: []float64{5, 1, 3}}
+	if got := odd.WinsorizedMean(0.7); got != 3 {
+		t.Errorf("WinsorizedMean(0.7) = %v, want 3", got)
+	}
+	if got := s.WinsorizedMean(0); got != s.Mean() {
+		t.Errorf("WinsorizedMean(0) = %v, want %v", got, s.Mean())
+	}
+
+	// Weighted Winsorizing should match Winsorizing the sample
+	// with the weights expanded into repeated values.
+	ws := Sample{Xs: []float64{4, 1, 100, 3, 2}, Weights: []float64{1, 1, 1, 2, 1}}
+	expanded := Sample{Xs: []float64{1, 2, 3, 3, 4, 100}}
+	if got, want := ws.WinsorizedMean(1.0/6), expanded.WinsorizedMean(1.0/6); !aeq(got, want) {
+		t.Errorf("weighted WinsorizedMean(1/6) = %v, want %v", got, want)
+	}
+	if got := ws.Winsorize(1.0 / 6); got.Xs[1] != 2 || got.Xs[2] != 4 || got.Weights[3] != 2 {
+		t.Errorf("weighted Winsorize(1/6) = %+v", got)
+	}
+}
+
+func TestSampleGeoMean(t *testing.T) {
+	for _, s := range []Sample{
+		{Xs: []float64{1, 2, 4}},
+		{Xs: []float64{1, 2, 4}, Weights: []float64{1, 1, 1}},
+		{Xs: []float64{-1, 1, 2, 4}, Weights: []float64{0, 1, 1, 1}},
+	} {
+		if got := s.GeoMean(); !aeq(got, 2) {
+			t.Errorf("%+v.GeoMean() = %v, want 2", s, got)
+		}
+	}
+	for _, s := range []Sample{
+		{},
+		{Xs: []float64{1, 0, 4}},
+		{Xs: []float64{1, -2, 4}},
+		{Xs: []float64{1, 0, 4}, Weights: []float64{1, 1, 1}},
+		{Xs: []float64{1, -2, 4}, Weights: []float64{1, 1, 1}},
+		{Xs: []float64{1, 2}, Weights: []float64{0, 0}},
+	} {
+		if got := s.GeoMean(); !math.IsNaN(got) {
+			t.Errorf("%+v.GeoMean() = %v, want NaN", s, got)
+		}
+	}
+}
+
+func TestSampleHarmonicMean(t *testing.T) {
+	// Driving one way at 60 mph and back at 40 mph averages 48 mph.
+	if got := HarmonicMean([]float64{60, 40}); !aeq(got, 48) {
+		t.Errorf("HarmonicMean(60, 40) = %v, want 48", got)
+	}
+	for _, s := range []Sample{
+		{Xs: []float64{60, 40}},
+		{Xs: []float64{60, 40}, Weights: []float64{1, 1}},
+		// Weights scale out.
+		{Xs: []float64{60, 40}, Weights: []float64{2.5, 2.5}},
+		{Xs: []float64{0, 60, 40}, Weights: []float64{0, 1, 1}},
+	} {
+		if got := s.HarmonicMean(); !aeq(got, 48) {
+			t.Errorf("%+v.HarmonicMean() = %v, want 48", s, got)
+		}
+	}
+	// Driving 120 miles at 60 mph and 40 miles at 40 mph takes 3
+	// hours, for an average of 160/3 mph.
+	s := Sample{Xs: []float64{60, 40}, Weights: []float64{120, 40}}
+	if got := s.HarmonicMean(); !aeq(got, 160.0/3) {
+		t.Errorf("%+v.HarmonicMean() = %v, want %v", s, got, 160.0/3)
+	}
+	for _, s := range []Sample{
+		{},
+		{Xs: []float64{1, 0, 4}},
+		{Xs: []float64{1, -2, 4}},
+		{Xs: []float64{1, 0, 4}, Weights: []float64{1, 1, 1}},
+		{Xs: []float64{1, 2}, Weights: []float64{0, 0}},
+	} {
+		if got := s.HarmonicMean(); !math.IsNaN(got) {
+			t.Errorf("%+v.HarmonicMean() = %v, want NaN", s, got)
+		}
+	}
+}
+
+func TestSampleMode(t *testing.T) {
+	check := func(name string, s Sample, f func(Sample) (float64, int), wantX float64, wantN int) {
+		t.Helper()
+		x, n := f(s)
+		if !(x == wantX || math.IsNaN(x) && math.IsNaN(wantX)) || n != wantN {
+			t.Errorf("%+v.%s = %v, %v; want %v, %v", s, name, x, n, wantX, wantN)
+		}
+	}
+	mode := func(s Sample) (float64, int) { return s.Mode() }
+
+	check("Mode()", Sample{Xs: []float64{3, 1, 2, 3, 2, 3}}, mode, 3, 3)
+	// Ties return the smallest value.
+	check("Mode()", Sample{Xs: []float64{5, 2, 5, 2, 7}}, mode, 2, 2)
+	check("Mode()", Sample{Xs: []float64{4}}, mode, 4, 1)
+	check("Mode()", Sample{}, mode, nan, 0)
+	// Weighted samples use the total weight of each value.
+	check("Mode()", Sample{Xs: []float64{1, 2, 1, 3}, Weights: []float64{1, 5, 1, 0}}, mode, 2, 1)
+	check("Mode()", Sample{Xs: []float64{1, 3, 3}, Weights: []float64{2, 1, 1}}, mode, 1, 1)
+
+	binned := func(w float64) func(Sample) (float64, int) {
+		return func(s Sample) (float64, int) { return s.ModeBinned(w) }
+	}
+	xs := Sample{Xs: []float64{0.1, 1.2, 1.4, 1.9, 2.05, 2.5, 2.95, 3.5, -0.5}}
+	check("ModeBinned(1)", xs, binned(1), 1.5, 3)
+	// [1, 1.5) and [2.5, 3) tie, so this returns the lower bin.
+	check("ModeBinned(0.5)", xs, binned(0.5), 1.25, 2)
+	check("ModeBinned(10)", xs, binned(10), 5, 8)
+	check("ModeBinned(0)", xs, binned(0), nan, 0)
+	check("ModeBinned(1)", Sample{}, binned(1), nan, 0)
+}
+
+func TestSkewnessKurtosis(t *testing.T) {
+	// Examples from the documentation of Excel's SKEW and KURT.
+	xs := []float64{3, 4, 5, 2, 3, 4, 5, 6, 4, 7}
+	s := Sample{Xs: xs}
+	if got := s.Skewness(); math.Abs(got-0.359543) > 1e-6 {
+		t.Errorf("Skewness(%v) = %v, want 0.359543", xs, got)
+	}
+	if got := s.Kurtosis(); math.Abs(got+0.151799637) > 1e-9 {
+		t.Errorf("Kurtosis(%v) = %v, want -0.151799637", xs, got)
+	}
+
+	// Symmetric samples have zero skewness.
+	sym := []float64{-3, -1, 0, 0, 1, 3}
+	if got := Skewness(sym); math.Abs(got) > 1e-15 {
+		t.Errorf("Skewness(%v) = %v, want 0", sym, got)
+	}
+	// A long right tail gives positive skewness, and a long left
+	// tail gives negative skewness.
+	if got := Skewness([]float64{1, 2, 3, 4, 20}); !(got > 1) {
+		t.Errorf("Skewness(1, 2, 3, 4, 20) = %v, want > 1", got)
+	}
+	if got := Skewness([]float64{-20, 1, 2, 3, 4}); !(got < -1) {
+		t.Errorf("Skewness(-20, 1, 2, 3, 4) = %v, want < -1", got)
+	}
+
+	for _, xs := range [][]float64{nil, {1, 2}, {1, 1, 1, 1}} {
+		if got := Skewness(xs); !math.IsNaN(got) {
+			t.Errorf("Skewness(%v) = %v, want NaN", xs, got)
+		}
+	}
+	for _, xs := range [][]float64{nil, {1, 2, 3}, {1, 1, 1, 1}} {
+		if got := Kurtosis(xs); !math.IsNaN(got) {
+			t.Errorf("Kurtosis(%v) = %v, want NaN", xs, got)
+		}
+	}
+}
+
+func TestSampleMAD(t *testing.T) {
+	// The median is 2 and the sorted absolute deviations are
+	// 0, 0, 1, 1, 2, 4, 7.
+	s := Sample{Xs: []float64{1, 1, 2, 2, 4, 6, 9}}
+	if got := s.MAD(); got != 1 {
+		t.Errorf("%+v.MAD() = %v, want 1", s, got)
+	}
+	// An outlier barely changes the MAD.
+	s.Xs[6] = 1e9
+	if got := s.MAD(); got != 1 {
+		t.Errorf("%+v.MAD() = %v, want 1", s, got)
+	}
+	// Zero-weight values don't contribute.
+	ws := Sample{Xs: []float64{100, 1, 2, 3}, Weights: []float64{0, 1, 1, 1}}
+	if got := ws.MAD(); got != 1 {
+		t.Errorf("%+v.MAD() = %v, want 1", ws, got)
+	}
+	if got := (Sample{}).MAD(); !math.IsNaN(got) {
+		t.Errorf("Sample{}.MAD() = %v, want NaN", got)
+	}
+
+	// On normal data, MADScaled estimates σ.
+	r := rand.New(rand.NewSource(1))
+	dist := NormalDist{Mu: 10, Sigma: 2}
+	normal := Sample{Xs: SampleN(dist, 10000, r)}
+	if got := normal.MADScaled(); math.Abs(got-2) > 0.05 {
+		t.Errorf("MADScaled() of %+v sample = %v, want ~2", dist, got)
+	}
+}
+
+func TestSampleQuantileMethod(t *testing.T) {
+	// Expected values from numpy.quantile(xs, q, method=...).
+	xs := []float64{7, 1, 11, 2, 4}
+	qs := []float64{0, 0.1, 0.3, 0.375, 0.5, 0.625, 0.9, 1}
+	for _, test := range []struct {
+		method QuantileMethod
+		want   []float64
+	}{
+		{QuantileLinear, []float64{1, 1.4, 2.4, 3, 4, 5.5, 9.4, 11}},
+		{QuantileLower, []float64{1, 1, 2, 2, 4, 4, 7, 11}},
+		{QuantileHigher, []float64{1, 2, 4, 4, 4, 7, 11, 11}},
+		{QuantileNearest, []float64{1, 1, 2, 4, 4, 4, 11, 11}},
+		{QuantileMidpoint, []float64{1, 1.5, 3, 3, 4, 5.5, 9, 11}},
+	} {
+		for _, sorted := range []bool{false, true} {
+			s := Sample{Xs: xs}
+			if sorted {
+				s = *s.Copy().Sort()
+			}
+			for i, q := range qs {
+				if got := s.QuantileMethod(q, test.method); !aeq(got, test.want[i]) {
+					t.Errorf("QuantileMethod(%v, %v) = %v, want %v", q, test.method, got, test.want[i])
+				}
+			}
+		}
+	}
+
+	// Quantile uses QuantileR8.
+	s := Sample{Xs: []float64{15, 20, 35, 40, 50}}
+	for _, q := range []float64{0.05, 0.3, 0.4, 0.5, 0.95} {
+		if a, b := s.Quantile(q), s.QuantileMethod(q, QuantileR8); a != b {
+			t.Errorf("Quantile(%v) = %v, but QuantileMethod(%v, QuantileR8) = %v", q, a, q, b)
+		}
+	}
+
+	// With equal weights, the weighted methods agree with the
+	// unweighted methods.
+	ws := Sample{Xs: xs, Weights: []float64{3, 3, 3, 3, 3}}
+	for _, method := range []QuantileMethod{QuantileLinear, QuantileLower, QuantileHigher, QuantileNearest, QuantileMidpoint} {
+		for _, q := range qs {
+			if a, b := ws.QuantileMethod(q, method), (Sample{Xs: xs}).QuantileMethod(q, method); !aeq(a, b) {
+				t.Errorf("weighted QuantileMethod(%v, %v) = %v, want %v", q, method, a, b)
+			}
+		}
+	}
+}
+
+func TestQuantileMethodString(t *testing.T) {
+	for m, want := range map[QuantileMethod]string{
+		QuantileR8:           "QuantileR8",
+		QuantileLinear:       "QuantileLinear",
+		QuantileMidpoint:     "QuantileMidpoint",
+		QuantileMidpoint + 1: "QuantileMethod(6)",
+	} {
+		if got := m.String(); got != want {
+			t.Errorf("QuantileMethod(%d).String() = %q, want %q", int(m), got, want)
+		}
+	}
+}
+
+func TestMeanVarMinMax(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, xs := range [][]float64{
+		{},
+		{3},
+		{1, 2},
+		{-1, 5, 2, 2, -7},
+		SampleN(NormalDist{1e6, 1}, 1000, r),
+	} {
+		mean, variance, min, max := MeanVarMinMax(xs)
+		wantMin, wantMax := Bounds(xs)
+		for _, c := range []struct {
+			name      string
+			got, want float64
+		}{
+			{"mean", mean, Mean(xs)},
+			{"variance", variance, Variance(xs)},
+			{"min", min, wantMin},
+			{"max", max, wantMax},
+		} {
+			if c.got != c.want && !(math.IsNaN(c.got) && math.IsNaN(c.want)) {
+				t.Errorf("MeanVarMinMax(%d values) %s = %v, want %v", len(xs), c.name, c.got, c.want)
+			}
+		}
+	}
+
+	xs := SampleN(StdNormal, 1000, r)
+	if allocs := testing.AllocsPerRun(100, func() { MeanVarMinMax(xs) }); allocs != 0 {
+		t.Errorf("MeanVarMinMax allocated %v times, want 0", allocs)
+	}
+}
+
+func BenchmarkMeanVarMinMax(b *testing.B) {
+	xs := SampleN(StdNormal, 1000, rand.New(rand.NewSource(1)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MeanVarMinMax(xs)
+	}
+}
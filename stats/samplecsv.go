@@ -0,0 +1,73 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrNotSorted is returned when data that was declared to be sorted is
+// not sorted.
+var ErrNotSorted = errors.New("values are not sorted")
+
+// ReadSampleCSV reads the values in the given zero-based column of
+// the CSV data from r into an unweighted Sample. The first record of
+// r is a header and is skipped. Records may have different numbers of
+// fields, but each must have the requested column.
+//
+// If sorted is true, the values are expected to be in ascending
+// order, and the returned Sample is marked as Sorted. If they are
+// not, ReadSampleCSV returns ErrNotSorted.
+//
+// Errors in the data are returned as a *csv.ParseError, which
+// includes the line and column of the error.
+func ReadSampleCSV(r io.Reader, column int, sorted bool) (Sample, error) {
+	if column < 0 {
+		return Sample{}, fmt.Errorf("invalid column %d", column)
+	}
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	// Skip the header.
+	if _, err := cr.Read(); err == io.EOF {
+		return Sample{Xs: []float64{}, Sorted: sorted}, nil
+	} else if err != nil {
+		return Sample{}, err
+	}
+
+	var xs []float64
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return Sample{}, err
+		}
+		if column >= len(rec) {
+			line, _ := cr.FieldPos(0)
+			return Sample{}, &csv.ParseError{StartLine: line, Line: line, Column: 1, Err: fmt.Errorf("record has %d fields, want column %d", len(rec), column)}
+		}
+		x, err := strconv.ParseFloat(strings.TrimSpace(rec[column]), 64)
+		if err != nil {
+			line, col := cr.FieldPos(column)
+			return Sample{}, &csv.ParseError{StartLine: line, Line: line, Column: col, Err: err}
+		}
+		xs = append(xs, x)
+	}
+	if xs == nil {
+		xs = []float64{}
+	}
+	if sorted && !sort.Float64sAreSorted(xs) {
+		return Sample{}, ErrNotSorted
+	}
+	return Sample{Xs: xs, Sorted: sorted}, nil
+}
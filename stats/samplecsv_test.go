@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"encoding/csv"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadSampleCSV(t *testing.T) {
+	const data = `name,value,weight
+a,1.5,1
+b, 2 ,1
+"c,d",-3e2,1
+`
+	s, err := ReadSampleCSV(strings.NewReader(data), 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{1.5, 2, -300}; !reflect.DeepEqual(s.Xs, want) || s.Weights != nil || s.Sorted {
+		t.Errorf("ReadSampleCSV = %+v, want Xs=%v", s, want)
+	}
+
+	s, err = ReadSampleCSV(strings.NewReader("x\n1\n2\n2\n5\n"), 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []float64{1, 2, 2, 5}; !reflect.DeepEqual(s.Xs, want) || !s.Sorted {
+		t.Errorf("ReadSampleCSV(sorted) = %+v, want sorted %v", s, want)
+	}
+	if _, err := ReadSampleCSV(strings.NewReader("x\n1\n3\n2\n"), 0, true); err != ErrNotSorted {
+		t.Errorf("ReadSampleCSV(unsorted, sorted=true): err = %v, want %v", err, ErrNotSorted)
+	}
+
+	// Header only and empty input give empty samples.
+	for _, in := range []string{"", "x\n"} {
+		s, err := ReadSampleCSV(strings.NewReader(in), 0, false)
+		if err != nil || len(s.Xs) != 0 {
+			t.Errorf("ReadSampleCSV(%q) = %+v, %v, want empty", in, s, err)
+		}
+	}
+}
+
+func TestReadSampleCSVErrors(t *testing.T) {
+	for _, c := range []struct {
+		data      string
+		column    int
+		line, col int
+	}{
+		// A malformed number.
+		{"x,y\n1,2\n3,4\n5,oops\n", 1, 4, 3},
+		// A missing column.
+		{"x,y\n1,2\n3\n", 1, 3, 1},
+		// A CSV syntax error.
+		{"x,y\n1,2\n3,\"4\n", 1, 3, 0},
+	} {
+		_, err := ReadSampleCSV(strings.NewReader(c.data), c.column, false)
+		var pe *csv.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("ReadSampleCSV(%q): err = %v, want *csv.ParseError", c.data, err)
+			continue
+		}
+		if pe.Line != c.line || (c.col != 0 && pe.Column != c.col) {
+			t.Errorf("ReadSampleCSV(%q): error at line %d column %d, want line %d column %d", c.data, pe.Line, pe.Column, c.line, c.col)
+		}
+	}
+
+	if _, err := ReadSampleCSV(strings.NewReader("x\n1\n"), -1, false); err == nil {
+		t.Errorf("ReadSampleCSV with column -1 succeeded")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// The binary encoding of a Sample is a version byte, a flags byte, the
+// number of values as a uvarint, the values as little-endian IEEE 754
+// float64s, and, if the Sample is weighted, the weights in the same
+// format.
+const (
+	sampleEncVersion = 1
+
+	sampleEncSorted   = 1 << 0
+	sampleEncWeighted = 1 << 1
+)
+
+var ErrInvalidSampleEncoding = errors.New("invalid Sample encoding")
+
+// MarshalBinary implements encoding.BinaryMarshaler, which
+// encoding/gob also uses. The encoding preserves the values,
+// weights, and Sorted flag of s exactly.
+func (s Sample) MarshalBinary() ([]byte, error) {
+	if s.Weights != nil && len(s.Weights) != len(s.Xs) {
+		return nil, ErrMismatchedSamples
+	}
+	var flags byte
+	if s.Sorted {
+		flags |= sampleEncSorted
+	}
+	n := len(s.Xs)
+	if s.Weights != nil {
+		flags |= sampleEncWeighted
+		n *= 2
+	}
+	buf := make([]byte, 2+binary.MaxVarintLen64+8*n)
+	buf[0], buf[1] = sampleEncVersion, flags
+	off := 2 + binary.PutUvarint(buf[2:], uint64(len(s.Xs)))
+	for _, x := range s.Xs {
+		binary.LittleEndian.PutUint64(buf[off:], math.Float64bits(x))
+		off += 8
+	}
+	for _, w := range s.Weights {
+		binary.LittleEndian.PutUint64(buf[off:], math.Float64bits(w))
+		off += 8
+	}
+	buf = buf[:off]
+	return buf, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It decodes
+// data produced by MarshalBinary into s, replacing its contents.
+func (s *Sample) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != sampleEncVersion || data[1]&^(sampleEncSorted|sampleEncWeighted) != 0 {
+		return ErrInvalidSampleEncoding
+	}
+	flags := data[1]
+	n, k := binary.Uvarint(data[2:])
+	if k <= 0 {
+		return ErrInvalidSampleEncoding
+	}
+	data = data[2+k:]
+	arrays := uint64(1)
+	if flags&sampleEncWeighted != 0 {
+		arrays = 2
+	}
+	if n > uint64(len(data))/8 || uint64(len(data)) != 8*arrays*n {
+		return ErrInvalidSampleEncoding
+	}
+
+	decode := func() []float64 {
+		xs := make([]float64, n)
+		for i := range xs {
+			xs[i] = math.Float64frombits(binary.LittleEndian.Uint64(data))
+			data = data[8:]
+		}
+		return xs
+	}
+	out := Sample{Xs: decode(), Sorted: flags&sampleEncSorted != 0}
+	if flags&sampleEncWeighted != 0 {
+		out.Weights = decode()
+	}
+	*s = out
+	return nil
+}
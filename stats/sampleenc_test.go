@@ -0,0 +1,90 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"encoding"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ encoding.BinaryMarshaler   = Sample{}
+	_ encoding.BinaryUnmarshaler = &Sample{}
+)
+
+func TestSampleBinary(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	xs := SampleN(NormalDist{3, 2}, 1000, r)
+	ws := SampleN(UniformDist{Lo: 0, Hi: 1}, 1000, r)
+	sorted := Sample{Xs: []float64{1, 2, 2, 5}}
+	sorted.Sort()
+	for _, s := range []Sample{
+		{},
+		{Xs: []float64{}},
+		{Xs: xs},
+		{Xs: xs, Weights: ws},
+		sorted,
+		{Xs: []float64{math.Inf(-1), -0.0, math.NaN(), math.MaxFloat64}, Weights: []float64{1, 0, 2, 3}},
+	} {
+		data, err := s.MarshalBinary()
+		if err != nil {
+			t.Errorf("MarshalBinary: %v", err)
+			continue
+		}
+		var got Sample
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Errorf("UnmarshalBinary: %v", err)
+			continue
+		}
+		if got.Sorted != s.Sorted || (got.Weights == nil) != (s.Weights == nil) {
+			t.Errorf("round trip: Sorted=%v weighted=%v, want %v, %v", got.Sorted, got.Weights != nil, s.Sorted, s.Weights != nil)
+		}
+		// Compare bit patterns so NaN and -0 count.
+		bits := func(xs []float64) []uint64 {
+			out := make([]uint64, len(xs))
+			for i, x := range xs {
+				out[i] = math.Float64bits(x)
+			}
+			return out
+		}
+		if !reflect.DeepEqual(bits(got.Xs), bits(s.Xs)) || !reflect.DeepEqual(bits(got.Weights), bits(s.Weights)) {
+			t.Errorf("round trip of %d values changed the data", len(s.Xs))
+		}
+		if len(s.Xs) > 0 && !math.IsNaN(s.Mean()) && got.Mean() != s.Mean() {
+			t.Errorf("round trip: Mean = %v, want %v", got.Mean(), s.Mean())
+		}
+	}
+
+	// A weighted sample encodes to 8 bytes per value and weight,
+	// plus a small header.
+	data, _ := Sample{Xs: xs, Weights: ws}.MarshalBinary()
+	if len(data) != 2+2+16*len(xs) {
+		t.Errorf("len(MarshalBinary) = %d, want %d", len(data), 2+2+16*len(xs))
+	}
+}
+
+func TestSampleBinaryErrors(t *testing.T) {
+	if _, err := (Sample{Xs: []float64{1, 2}, Weights: []float64{1}}).MarshalBinary(); err != ErrMismatchedSamples {
+		t.Errorf("MarshalBinary of mismatched weights: err = %v, want %v", err, ErrMismatchedSamples)
+	}
+
+	good, _ := Sample{Xs: []float64{1, 2}, Weights: []float64{3, 4}}.MarshalBinary()
+	for name, data := range map[string][]byte{
+		"empty":     nil,
+		"version":   append([]byte{2}, good[1:]...),
+		"flags":     append([]byte{1, 0x80}, good[2:]...),
+		"truncated": good[:len(good)-1],
+		"trailing":  append(append([]byte(nil), good...), 0),
+		"length":    {1, 0, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	} {
+		var s Sample
+		if err := s.UnmarshalBinary(data); err != ErrInvalidSampleEncoding {
+			t.Errorf("UnmarshalBinary(%s): err = %v, want %v", name, err, ErrInvalidSampleEncoding)
+		}
+	}
+}
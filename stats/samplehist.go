@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+// Histogram returns a histogram of the Sample with the given number
+// of equal-width bins spanning the bounds of the Sample. edges has
+// length bins+1, and counts[i] is the total weight of values in
+// [edges[i], edges[i+1]). The last bin also includes its upper edge,
+// so every value is counted.
+//
+// If all values are equal, the bins span a range of width 1 centered
+// on that value, so exactly one bin is nonzero. If the Sample is
+// empty, the bins span [0, 1].
+//
+// Histogram panics if bins < 1.
+func (s Sample) Histogram(bins int) (edges []float64, counts []float64) {
+	if bins < 1 {
+		panic("bins must be at least 1")
+	}
+	lo, hi := s.Bounds()
+	if math.IsNaN(lo) {
+		// No values (or no values with non-zero weight).
+		lo, hi = 0, 1
+	} else if lo == hi {
+		lo, hi = lo-0.5, hi+0.5
+	}
+	edges = vec.Linspace(lo, hi, bins+1)
+	// Make sure round-off doesn't exclude the maximum.
+	edges[bins] = hi
+	return edges, s.HistogramEdges(edges)
+}
+
+// HistogramEdges returns a histogram of the Sample with the given bin
+// edges, which must be in increasing order. counts has length
+// len(edges)-1, and counts[i] is the total weight of values in
+// [edges[i], edges[i+1]). The last bin also includes its upper edge.
+// Values outside [edges[0], edges[len(edges)-1]] are not counted.
+func (s Sample) HistogramEdges(edges []float64) (counts []float64) {
+	if len(edges) < 2 {
+		return nil
+	}
+	counts = make([]float64, len(edges)-1)
+	last := edges[len(edges)-1]
+	for i, x := range s.Xs {
+		if !(x >= edges[0] && x <= last) {
+			continue
+		}
+		// Find the first edge > x. x is in the bin to its left.
+		bin := sort.Search(len(edges), func(i int) bool { return edges[i] > x }) - 1
+		if bin == len(counts) {
+			// x is exactly the last edge.
+			bin--
+		}
+		if s.Weights == nil {
+			counts[bin]++
+		} else {
+			counts[bin] += s.Weights[i]
+		}
+	}
+	return counts
+}
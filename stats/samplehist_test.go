@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestSampleHistogram(t *testing.T) {
+	check := func(name string, got, want []float64) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Errorf("%s = %v, want %v", name, got, want)
+			return
+		}
+		for i := range got {
+			if !aeq(got[i], want[i]) {
+				t.Errorf("%s = %v, want %v", name, got, want)
+				return
+			}
+		}
+	}
+
+	s := Sample{Xs: []float64{0, 1, 1.5, 2, 4, 3.9, 4}}
+	edges, counts := s.Histogram(4)
+	check("edges", edges, []float64{0, 1, 2, 3, 4})
+	check("counts", counts, []float64{1, 2, 1, 3})
+
+	ws := Sample{Xs: s.Xs, Weights: []float64{1, 0.5, 0.5, 2, 1, 1, 0}}
+	edges, counts = ws.Histogram(2)
+	check("weighted edges", edges, []float64{0, 2, 4})
+	check("weighted counts", counts, []float64{2, 4})
+
+	// Identical values fall in a single bin.
+	same := Sample{Xs: []float64{5, 5, 5}}
+	edges, counts = same.Histogram(3)
+	check("degenerate edges", edges, []float64{4.5, 4.5 + 1.0/3, 4.5 + 2.0/3, 5.5})
+	check("degenerate counts", counts, []float64{0, 3, 0})
+
+	edges, counts = Sample{}.Histogram(2)
+	check("empty edges", edges, []float64{0, 0.5, 1})
+	check("empty counts", counts, []float64{0, 0})
+
+	// Custom edges need not be uniform and need not cover the
+	// sample.
+	counts = s.HistogramEdges([]float64{1, 1.5, 4})
+	check("HistogramEdges", counts, []float64{1, 5})
+	if counts := s.HistogramEdges([]float64{1}); counts != nil {
+		t.Errorf("HistogramEdges with one edge = %v, want nil", counts)
+	}
+}
+
+func TestSampleHistogramTotal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	xs := SampleN(NormalDist{Mu: 0, Sigma: 1}, 1000, r)
+	ws := SampleN(UniformDist{Lo: 0, Hi: 2}, 1000, r)
+	for _, s := range []Sample{{Xs: xs}, {Xs: xs, Weights: ws}} {
+		for _, bins := range []int{1, 7, 50} {
+			_, counts := s.Histogram(bins)
+			if got, want := vec.Sum(counts), s.Weight(); !aeq(got, want) {
+				t.Errorf("Histogram(%d) counts sum to %v, want total weight %v", bins, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"runtime"
+	"sort"
+	"sync"
+)
+
+// parallelSortThreshold is the sample size below which SortParallel
+// uses the serial sort, since the goroutine and merge overhead
+// outweighs the gains.
+const parallelSortThreshold = 1 << 16
+
+// SortParallel is like Sort, but sorts large samples using up to
+// workers goroutines. Weights are permuted along with their values.
+// If workers <= 0, it uses runtime.GOMAXPROCS(0) workers.
+//
+// SortParallel sorts chunks of s concurrently and then merges them
+// in parallel rounds, using scratch space the size of s. For samples
+// smaller than a threshold, or if workers is 1, it simply calls Sort.
+func (s *Sample) SortParallel(workers int) *Sample {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if s.Sorted || workers == 1 || len(s.Xs) < parallelSortThreshold || sort.Float64sAreSorted(s.Xs) {
+		return s.Sort()
+	}
+	n := len(s.Xs)
+	if maxWorkers := n / (parallelSortThreshold / 4); workers > maxWorkers {
+		workers = maxWorkers
+	}
+
+	// Sort the chunks.
+	bounds := make([]int, workers+1)
+	for i := range bounds {
+		bounds[i] = i * n / workers
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		chunk := Sample{Xs: s.Xs[bounds[i]:bounds[i+1]]}
+		if s.Weights != nil {
+			chunk.Weights = s.Weights[bounds[i]:bounds[i+1]]
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			chunk.Sort()
+		}()
+	}
+	wg.Wait()
+
+	// Merge adjacent pairs of runs until there is one run left,
+	// alternating between s and the scratch buffers.
+	src, dst := Sample{Xs: s.Xs, Weights: s.Weights}, Sample{Xs: make([]float64, n)}
+	if s.Weights != nil {
+		dst.Weights = make([]float64, n)
+	}
+	for len(bounds) > 2 {
+		var next []int
+		for i := 0; i+1 < len(bounds); i += 2 {
+			lo := bounds[i]
+			next = append(next, lo)
+			if i+2 >= len(bounds) {
+				// Odd run out; copy it over.
+				copy(dst.Xs[lo:], src.Xs[lo:])
+				if src.Weights != nil {
+					copy(dst.Weights[lo:], src.Weights[lo:])
+				}
+				continue
+			}
+			mid, hi := bounds[i+1], bounds[i+2]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				mergeSample(dst, src, lo, mid, hi)
+			}()
+		}
+		wg.Wait()
+		bounds = append(next, n)
+		src, dst = dst, src
+	}
+	if &src.Xs[0] != &s.Xs[0] {
+		copy(s.Xs, src.Xs)
+		if s.Weights != nil {
+			copy(s.Weights, src.Weights)
+		}
+	}
+	s.Sorted = true
+	return s
+}
+
+// mergeSample merges the sorted runs src[lo:mid] and src[mid:hi]
+// into dst[lo:hi], keeping weights with their values. Equal values
+// from the first run come first. It orders NaNs before other values,
+// like sort.Float64s.
+func mergeSample(dst, src Sample, lo, mid, hi int) {
+	less := func(a, b float64) bool {
+		return a < b || (math.IsNaN(a) && !math.IsNaN(b))
+	}
+	i, j := lo, mid
+	for k := lo; k < hi; k++ {
+		from := i
+		if i >= mid || (j < hi && less(src.Xs[j], src.Xs[i])) {
+			from = j
+			j++
+		} else {
+			i++
+		}
+		dst.Xs[k] = src.Xs[from]
+		if src.Weights != nil {
+			dst.Weights[k] = src.Weights[from]
+		}
+	}
+}
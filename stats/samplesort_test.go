@@ -0,0 +1,104 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func randSample(r *rand.Rand, n int, ties, weighted bool) Sample {
+	s := Sample{Xs: make([]float64, n)}
+	if weighted {
+		s.Weights = make([]float64, n)
+	}
+	for i := range s.Xs {
+		if ties {
+			s.Xs[i] = float64(r.Intn(100))
+		} else {
+			s.Xs[i] = r.Float64()
+		}
+		if weighted {
+			s.Weights[i] = float64(i)
+		}
+	}
+	return s
+}
+
+func TestSortParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 10, parallelSortThreshold - 1, parallelSortThreshold, 2*parallelSortThreshold + 1} {
+		for _, workers := range []int{0, 1, 3, 8} {
+			for _, weighted := range []bool{false, true} {
+				for _, ties := range []bool{false, true} {
+					s := randSample(r, n, ties, weighted)
+					want := s.Copy().Sort()
+					got := s.Copy().SortParallel(workers)
+					if !got.Sorted {
+						t.Errorf("n=%d workers=%d: Sorted not set", n, workers)
+					}
+					if !sort.Float64sAreSorted(got.Xs) {
+						t.Errorf("n=%d workers=%d: result not sorted", n, workers)
+						continue
+					}
+					if !weighted {
+						continue
+					}
+					// The weights were distinct, so they
+					// identify each value. With ties, the
+					// serial sort is not stable, so compare
+					// the sets of (x, w) pairs instead.
+					pairs := func(s *Sample) map[[2]float64]int {
+						m := make(map[[2]float64]int)
+						for i, x := range s.Xs {
+							m[[2]float64{x, s.Weights[i]}]++
+						}
+						return m
+					}
+					if ties {
+						wp, gp := pairs(want), pairs(got)
+						for k, v := range wp {
+							if gp[k] != v {
+								t.Errorf("n=%d workers=%d: weight pairing differs", n, workers)
+								break
+							}
+						}
+						continue
+					}
+					for i := range want.Xs {
+						if want.Xs[i] != got.Xs[i] || want.Weights[i] != got.Weights[i] {
+							t.Errorf("n=%d workers=%d: element %d = (%v, %v), want (%v, %v)", n, workers, i, got.Xs[i], got.Weights[i], want.Xs[i], want.Weights[i])
+							break
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func benchmarkSort(b *testing.B, sortFn func(*Sample)) {
+	r := rand.New(rand.NewSource(1))
+	orig := randSample(r, 1<<22, false, true)
+	s := orig.Copy()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		copy(s.Xs, orig.Xs)
+		copy(s.Weights, orig.Weights)
+		s.Sorted = false
+		b.StartTimer()
+		sortFn(s)
+	}
+}
+
+func BenchmarkSampleSort(b *testing.B) {
+	benchmarkSort(b, func(s *Sample) { s.Sort() })
+}
+
+func BenchmarkSampleSortParallel(b *testing.B) {
+	benchmarkSort(b, func(s *Sample) { s.SortParallel(0) })
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+// A SignTestResult is the result of a sign test.
+type SignTestResult struct {
+	// N is the number of pairs with non-zero differences. Tied
+	// pairs are ignored.
+	N int
+
+	// Positive is the number of pairs for which x[i] > y[i].
+	Positive int
+
+	// AltHypothesis specifies the alternative hypothesis tested
+	// by this test against the null hypothesis that x[i] > y[i]
+	// and x[i] < y[i] are equally likely.
+	AltHypothesis LocationHypothesis
+
+	// P is the p-value of the test for the given null
+	// hypothesis.
+	P float64
+}
+
+// SignTest performs a sign test on paired samples x and y. This tests
+// the null hypothesis that the median of the differences x[i]-y[i] is
+// 0 by counting the positive differences and comparing against a
+// binomial distribution with probability 1/2.
+//
+// The sign test makes fewer assumptions than
+// WilcoxonSignedRankTest, which additionally assumes the differences
+// are symmetric, but is less powerful.
+//
+// This can fail with ErrMismatchedSamples if x and y have different
+// lengths, ErrSampleSize if they are empty, or ErrSamplesEqual if all
+// pairs are tied.
+func SignTest(x, y []float64, alt LocationHypothesis) (*SignTestResult, error) {
+	if len(x) != len(y) {
+		return nil, ErrMismatchedSamples
+	}
+	if len(x) == 0 {
+		return nil, ErrSampleSize
+	}
+	n, pos := 0, 0
+	for i := range x {
+		if x[i] > y[i] {
+			n++
+			pos++
+		} else if x[i] < y[i] {
+			n++
+		}
+	}
+	if n == 0 {
+		return nil, ErrSamplesEqual
+	}
+	p, err := BinomialTest(pos, n, 0.5, alt)
+	if err != nil {
+		return nil, err
+	}
+	return &SignTestResult{N: n, Positive: pos, AltHypothesis: alt, P: p}, nil
+}
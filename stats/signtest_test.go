@@ -0,0 +1,39 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "testing"
+
+func TestSignTest(t *testing.T) {
+	// Every pair but one increases, and one pair is tied.
+	before := []float64{10, 12, 9, 15, 11, 13, 14, 10, 12}
+	after := []float64{12, 15, 9, 18, 14, 15, 13, 13, 16}
+	for _, c := range []struct {
+		alt LocationHypothesis
+		p   float64
+	}{
+		{LocationLess, 9.0 / 256},
+		{LocationGreater, 255.0 / 256},
+		{LocationDiffers, 18.0 / 256},
+	} {
+		res, err := SignTest(before, after, c.alt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.N != 8 || res.Positive != 1 || res.AltHypothesis != c.alt || !aeq(res.P, c.p) {
+			t.Errorf("SignTest(%v) = %+v, want N=8, Positive=1, P=%v", c.alt, res, c.p)
+		}
+	}
+
+	if _, err := SignTest(before, after[:3], LocationDiffers); err != ErrMismatchedSamples {
+		t.Errorf("mismatched lengths returned error %v, want ErrMismatchedSamples", err)
+	}
+	if _, err := SignTest(nil, nil, LocationDiffers); err != ErrSampleSize {
+		t.Errorf("empty samples returned error %v, want ErrSampleSize", err)
+	}
+	if _, err := SignTest(before, before, LocationDiffers); err != ErrSamplesEqual {
+		t.Errorf("all ties returned error %v, want ErrSamplesEqual", err)
+	}
+}
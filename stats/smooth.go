@@ -0,0 +1,121 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// MovingAverage returns the trailing simple moving average of xs with
+// the given window size. The result has the same length as xs, and
+// element i is the mean of xs[i-window+1] through xs[i].
+//
+// At the start of xs, where fewer than window values are available,
+// element i is the mean of the i+1 values xs[0] through xs[i]. Hence
+// the first element is always xs[0].
+//
+// MovingAverage panics if window < 1.
+func MovingAverage(xs []float64, window int) []float64 {
+	if window < 1 {
+		panic("window must be at least 1")
+	}
+	out := make([]float64, len(xs))
+	sum := 0.0
+	for i, x := range xs {
+		sum += x
+		if i >= window {
+			sum -= xs[i-window]
+		}
+		out[i] = sum / float64(minint(i+1, window))
+	}
+	return out
+}
+
+// EWMA returns the exponentially weighted moving average of xs with
+// smoothing factor alpha. The result has the same length as xs, with
+//
+//	y_0 = x_0
+//	y_i = alpha x_i + (1-alpha) y_(i-1)
+//
+// Larger values of alpha discount older values faster. alpha = 1
+// returns a copy of xs.
+//
+// EWMA panics if alpha is not in (0, 1].
+func EWMA(xs []float64, alpha float64) []float64 {
+	if !(alpha > 0 && alpha <= 1) {
+		panic("alpha must be in (0, 1]")
+	}
+	out := make([]float64, len(xs))
+	for i, x := range xs {
+		if i == 0 {
+			out[i] = x
+		} else {
+			out[i] = alpha*x + (1-alpha)*out[i-1]
+		}
+	}
+	return out
+}
+
+// EWVar tracks the exponentially weighted moving mean and variance
+// of a stream of data with smoothing factor Alpha. The mean follows
+// the same recurrence as EWMA, and the variance is the
+// exponentially weighted mean of squared deviations from it, as
+// described by Finch (2009), "Incremental calculation of weighted
+// mean and variance".
+//
+// EWVar should be constructed with NewEWVar.
+type EWVar struct {
+	// Count is the number of values added.
+	Count uint
+
+	alpha          float64
+	mean, variance float64
+}
+
+// NewEWVar returns an EWVar with smoothing factor alpha. Larger
+// values of alpha discount older values faster.
+//
+// NewEWVar panics if alpha is not in (0, 1].
+func NewEWVar(alpha float64) *EWVar {
+	if !(alpha > 0 && alpha <= 1) {
+		panic("alpha must be in (0, 1]")
+	}
+	return &EWVar{alpha: alpha}
+}
+
+// Add updates s's statistics with sample value x.
+func (s *EWVar) Add(x float64) {
+	s.Count++
+	if s.Count == 1 {
+		s.mean, s.variance = x, 0
+		return
+	}
+	diff := x - s.mean
+	incr := s.alpha * diff
+	s.mean += incr
+	s.variance = (1 - s.alpha) * (s.variance + diff*incr)
+}
+
+// Mean returns the exponentially weighted mean, or NaN if no values
+// have been added.
+func (s *EWVar) Mean() float64 {
+	if s.Count == 0 {
+		return nan
+	}
+	return s.mean
+}
+
+// Variance returns the exponentially weighted variance, or NaN if no
+// values have been added.
+func (s *EWVar) Variance() float64 {
+	if s.Count == 0 {
+		return nan
+	}
+	return s.variance
+}
+
+// StdDev returns the square root of the exponentially weighted
+// variance.
+func (s *EWVar) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
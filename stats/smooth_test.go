@@ -0,0 +1,117 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func checkSlice(t *testing.T, name string, got, want []float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+		return
+	}
+	for i := range got {
+		if !aeq(got[i], want[i]) {
+			t.Errorf("%s = %v, want %v", name, got, want)
+			return
+		}
+	}
+}
+
+func TestMovingAverage(t *testing.T) {
+	xs := []float64{1, 2, 3, 4, 5, 6}
+	// The first window-1 values average over what's available.
+	checkSlice(t, "MovingAverage(xs, 3)", MovingAverage(xs, 3), []float64{1, 1.5, 2, 3, 4, 5})
+	checkSlice(t, "MovingAverage(xs, 1)", MovingAverage(xs, 1), xs)
+	// A window at least as long as xs is the running mean.
+	checkSlice(t, "MovingAverage(xs, 6)", MovingAverage(xs, 6), []float64{1, 1.5, 2, 2.5, 3, 3.5})
+	checkSlice(t, "MovingAverage(xs, 10)", MovingAverage(xs, 10), []float64{1, 1.5, 2, 2.5, 3, 3.5})
+	if got := MovingAverage(nil, 3); len(got) != 0 {
+		t.Errorf("MovingAverage(nil, 3) = %v, want []", got)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("MovingAverage(xs, 0) did not panic")
+			}
+		}()
+		MovingAverage(xs, 0)
+	}()
+}
+
+func TestEWMA(t *testing.T) {
+	xs := []float64{4, 8, 0, 4}
+	checkSlice(t, "EWMA(xs, 0.5)", EWMA(xs, 0.5), []float64{4, 6, 3, 3.5})
+	checkSlice(t, "EWMA(xs, 1)", EWMA(xs, 1), xs)
+
+	// EWMA of a step converges to the new constant.
+	step := make([]float64, 200)
+	for i := 1; i < len(step); i++ {
+		step[i] = 10
+	}
+	ys := EWMA(step, 0.1)
+	for i := 1; i < len(ys); i++ {
+		if ys[i] < ys[i-1] || ys[i] > 10 {
+			t.Fatalf("EWMA of step is not monotonically approaching 10: %v", ys)
+		}
+	}
+	if last := ys[len(ys)-1]; math.Abs(last-10) > 1e-8 {
+		t.Errorf("EWMA of constant input converged to %v, want 10", last)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("EWMA(xs, 0) did not panic")
+			}
+		}()
+		EWMA(xs, 0)
+	}()
+}
+
+func TestEWVar(t *testing.T) {
+	s := NewEWVar(0.5)
+	if !math.IsNaN(s.Mean()) || !math.IsNaN(s.Variance()) {
+		t.Errorf("empty EWVar: Mean=%v Variance=%v, want NaN", s.Mean(), s.Variance())
+	}
+	s.Add(2)
+	if s.Mean() != 2 || s.Variance() != 0 {
+		t.Errorf("after one value: Mean=%v Variance=%v, want 2, 0", s.Mean(), s.Variance())
+	}
+	// mean = 2 + 0.5*(4-2) = 3, variance = 0.5*(0 + 2*1) = 1.
+	s.Add(4)
+	if s.Mean() != 3 || s.Variance() != 1 {
+		t.Errorf("after two values: Mean=%v Variance=%v, want 3, 1", s.Mean(), s.Variance())
+	}
+
+	// The mean agrees with EWMA.
+	r := rand.New(rand.NewSource(1))
+	xs := SampleN(NormalDist{5, 3}, 100000, r)
+	s = NewEWVar(0.001)
+	ewma := EWMA(xs, 0.001)
+	var avg StreamStats
+	for i, x := range xs {
+		s.Add(x)
+		if math.Abs(s.Mean()-ewma[i]) > 1e-9 {
+			t.Fatalf("after %d values: Mean = %v, want EWMA %v", i+1, s.Mean(), ewma[i])
+		}
+		if i >= len(xs)/2 {
+			avg.Add(s.Variance())
+		}
+	}
+	// On stationary input, the variance converges near the true
+	// variance of 9.
+	if v := s.Variance(); math.Abs(v-9) > 0.2*9 {
+		t.Errorf("final Variance = %v, want ≈ 9", v)
+	}
+	if v := avg.Mean(); math.Abs(v-9) > 0.03*9 {
+		t.Errorf("time-averaged Variance = %v, want ≈ 9", v)
+	}
+}
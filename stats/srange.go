@@ -0,0 +1,135 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// StudentizedRangeDist is the studentized range distribution with K
+// groups and DF degrees of freedom. This is the distribution of the
+// range of K independent standard normal variables divided by an
+// independent sqrt(χ²(DF)/DF). It is the basis of Tukey's HSD test
+// (TukeyHSD).
+//
+// The CDF is computed by numerical integration of the standard
+// double integral: an inner integral over the normal density giving
+// the CDF of the range, and an outer integral over the density of the
+// studentizing factor. The CDF is accurate to about 1e-8 absolute
+// error, and critical values computed by InvCDF to about 1e-6.
+// Evaluating the CDF takes milliseconds, and InvCDF evaluates the CDF
+// many times, so callers should reuse critical values where possible.
+// If DF is +Inf or very large, the outer integral is skipped.
+type StudentizedRangeDist struct {
+	// K is the number of groups. K >= 2.
+	K int
+
+	// DF is the degrees of freedom. DF > 0. It may be +Inf.
+	DF float64
+}
+
+func (d StudentizedRangeDist) CDF(q float64) float64 {
+	return ptukey(q, float64(d.K), d.DF)
+}
+
+// InvCDF returns the p'th quantile of d, found by root finding on
+// the CDF. For example, InvCDF(0.95) is the critical value of Tukey's
+// HSD test at the 0.05 level.
+func (d StudentizedRangeDist) InvCDF(p float64) float64 {
+	return qtukey(p, float64(d.K), d.DF)
+}
+
+// Bounds returns the 0th and 99th percentiles of d.
+func (d StudentizedRangeDist) Bounds() (float64, float64) {
+	return 0, d.InvCDF(0.99)
+}
+
+// srangeSteps is the number of Simpson's rule steps used for each
+// of the integrals in the studentized range CDF. Both integrands are
+// smooth and decay rapidly, and comparison with much finer
+// integration shows 128 steps gives an error below 1e-12 across
+// typical parameters.
+const srangeSteps = 128
+
+// rangeCDF returns the CDF at w of the range of k independent
+// standard normal variables,
+//
+//	k ∫ φ(z) (Φ(z+w) - Φ(z))^(k-1) dz.
+func rangeCDF(w, k float64) float64 {
+	if w <= 0 {
+		return 0
+	}
+	f := func(z float64) float64 {
+		// Compute Φ(z+w) - Φ(z) from whichever tail keeps
+		// precision.
+		var d float64
+		if z >= -w/2 {
+			d = (math.Erfc(z/math.Sqrt2) - math.Erfc((z+w)/math.Sqrt2)) / 2
+		} else {
+			d = (math.Erfc(-(z+w)/math.Sqrt2) - math.Erfc(-z/math.Sqrt2)) / 2
+		}
+		return k * math.Exp(-z*z/2) * invSqrt2Pi * math.Pow(d, k-1)
+	}
+	// Outside ±8.5, φ(z) is below machine precision. The
+	// integrand is smooth, so a fixed Simpson's rule converges
+	// quickly; it is also much faster than adaptive integration
+	// when nested inside ptukey.
+	sum := integrate(f, -8.5, 8.5, srangeSteps)
+	return math.Min(sum, 1)
+}
+
+// ptukey returns the CDF at q of the studentized range distribution
+// for k groups and df degrees of freedom. That is the distribution
+// of the range of k independent standard normal variables divided by
+// an independent sqrt(χ²(df)/df).
+func ptukey(q, k, df float64) float64 {
+	if q <= 0 {
+		return 0
+	} else if math.IsInf(q, 1) {
+		return 1
+	}
+	if math.IsInf(df, 1) || df > 1e5 {
+		return rangeCDF(q, k)
+	}
+
+	// Integrate rangeCDF(q s) against the density of
+	// s = sqrt(χ²(df)/df), which is concentrated around 1 with
+	// standard deviation about 1/sqrt(2 df).
+	logNorm := df/2*math.Log(df) - lgamma(df/2) - (df/2-1)*math.Ln2
+	f := func(s float64) float64 {
+		if s <= 0 {
+			return 0
+		}
+		return rangeCDF(q*s, k) * math.Exp(logNorm+(df-1)*math.Log(s)-df*s*s/2)
+	}
+	sd := 1 / math.Sqrt(2*df)
+	lo, hi := math.Max(0, 1-15*sd), 1+15*sd
+	// rangeCDF(q s) rises from 0 to nearly 1 over s in about
+	// [0, 12/q], which can be a sharp step if q is large, so
+	// integrate that separately.
+	sum := 0.0
+	if mid := 12 / q; lo < mid && mid < hi {
+		sum = integrateAdaptive(f, lo, mid, 1e-10) + integrateAdaptive(f, mid, hi, 1e-10)
+	} else {
+		sum = integrateAdaptive(f, lo, hi, 1e-10)
+	}
+	return math.Max(0, math.Min(sum, 1))
+}
+
+// qtukey returns the p'th quantile of the studentized range
+// distribution for k groups and df degrees of freedom.
+func qtukey(p, k, df float64) float64 {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return nan
+	} else if p == 0 {
+		return 0
+	} else if p == 1 {
+		return inf
+	}
+	hi := 8.0
+	for ptukey(hi, k, df) < p {
+		hi *= 2
+	}
+	q, _ := brent(func(q float64) float64 { return ptukey(q, k, df) - p }, 0, hi, 1e-12)
+	return q
+}
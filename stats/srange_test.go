@@ -0,0 +1,85 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPtukey(t *testing.T) {
+	// For k = 2, the range is |Z1 - Z2| = √2 |Z|, so the
+	// studentized range is √2 times the absolute value of a t
+	// variable.
+	for _, df := range []float64{1, 3, 10, 100, inf} {
+		for _, q := range []float64{0.1, 1, 2.5, 5, 20} {
+			var want float64
+			if math.IsInf(df, 1) {
+				want = 2*StdNormal.CDF(q/math.Sqrt2) - 1
+			} else {
+				want = 2*TDist{df}.CDF(q/math.Sqrt2) - 1
+			}
+			if got := ptukey(q, 2, df); math.Abs(got-want) > 1e-8 {
+				t.Errorf("ptukey(%v, 2, %v) = %v, want %v", q, df, got, want)
+			}
+		}
+	}
+	if got := ptukey(0, 3, 10); got != 0 {
+		t.Errorf("ptukey(0, 3, 10) = %v, want 0", got)
+	}
+}
+
+func TestQtukey(t *testing.T) {
+	// Tabulated critical values. See, e.g., Harter (1960),
+	// "Tables of range and studentized range".
+	for _, c := range []struct{ p, k, df, want float64 }{
+		{0.95, 2, inf, 2.772},
+		{0.95, 3, 10, 3.877},
+		{0.95, 3, 27, 3.506},
+		{0.95, 4, 20, 3.958},
+		{0.99, 5, 12, 5.836},
+	} {
+		if got := qtukey(c.p, c.k, c.df); math.Abs(got-c.want) > 1e-3 {
+			t.Errorf("qtukey(%v, %v, %v) = %v, want %v", c.p, c.k, c.df, got, c.want)
+		}
+	}
+}
+
+func TestStudentizedRangeDist(t *testing.T) {
+	// Tabulated upper 5% and 1% critical values.
+	for _, c := range []struct {
+		k     int
+		df, p float64
+		want  float64
+	}{
+		{3, 10, 0.95, 3.877},
+		{2, 5, 0.95, 3.635},
+		{10, 30, 0.95, 4.824},
+		{3, inf, 0.99, 4.120},
+	} {
+		d := StudentizedRangeDist{c.k, c.df}
+		if got := d.InvCDF(c.p); math.Abs(got-c.want) > 1e-3 {
+			t.Errorf("%v.InvCDF(%v) = %v, want %v", d, c.p, got, c.want)
+		}
+	}
+
+	d := StudentizedRangeDist{4, 15}
+	testFunc(t, "StudentizedRangeDist{4, 15}.CDF", d.CDF, map[float64]float64{
+		-1:  0,
+		0:   0,
+		inf: 1,
+	})
+	testFunc(t, "StudentizedRangeDist{4, 15}.InvCDF", d.InvCDF, map[float64]float64{
+		-0.1: nan,
+		0:    0,
+		1:    inf,
+		1.1:  nan,
+	})
+	for _, p := range []float64{0.01, 0.5, 0.9, 0.999} {
+		if got := d.CDF(d.InvCDF(p)); math.Abs(got-p) > 1e-9 {
+			t.Errorf("%v.CDF(InvCDF(%v)) = %v", d, p, got)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+// ZScore returns the standard scores of xs: each value minus the mean
+// of xs, divided by the sample standard deviation of xs. The result
+// has mean 0 and standard deviation 1.
+//
+// If all values in xs are equal, ZScore returns all zeros rather than
+// NaN, since every value is exactly at the mean.
+func ZScore(xs []float64) []float64 {
+	out := make([]float64, len(xs))
+	if len(xs) == 0 {
+		return out
+	}
+	mean, sd := Mean(xs), StdDev(xs)
+	if sd == 0 {
+		return out
+	}
+	for i, x := range xs {
+		out[i] = (x - mean) / sd
+	}
+	return out
+}
+
+// MinMaxScale returns xs linearly rescaled so that its minimum maps to
+// lo and its maximum maps to hi. lo may be greater than hi, in which
+// case the order of values is reversed.
+//
+// If all values in xs are equal, MinMaxScale maps them all to the
+// midpoint of lo and hi.
+func MinMaxScale(xs []float64, lo, hi float64) []float64 {
+	out := make([]float64, len(xs))
+	if len(xs) == 0 {
+		return out
+	}
+	min, max := Bounds(xs)
+	if min == max {
+		for i := range out {
+			out[i] = (lo + hi) / 2
+		}
+		return out
+	}
+	scale := (hi - lo) / (max - min)
+	for i, x := range xs {
+		out[i] = lo + (x-min)*scale
+	}
+	// Make sure the extremes map exactly to lo and hi despite
+	// round-off.
+	for i, x := range xs {
+		if x == min {
+			out[i] = lo
+		} else if x == max {
+			out[i] = hi
+		}
+	}
+	return out
+}
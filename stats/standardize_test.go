@@ -0,0 +1,46 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestZScore(t *testing.T) {
+	checkSlice(t, "ZScore", ZScore([]float64{2, 4, 6}), []float64{-1, 0, 1})
+
+	r := rand.New(rand.NewSource(1))
+	xs := SampleN(GammaDist{Shape: 2, Scale: 3}, 1000, r)
+	zs := ZScore(xs)
+	if m := Mean(zs); math.Abs(m) > 1e-12 {
+		t.Errorf("mean of ZScore = %v, want 0", m)
+	}
+	if sd := StdDev(zs); math.Abs(sd-1) > 1e-12 {
+		t.Errorf("standard deviation of ZScore = %v, want 1", sd)
+	}
+
+	// Zero variance gives zeros, not NaN.
+	checkSlice(t, "ZScore of constant", ZScore([]float64{5, 5, 5}), []float64{0, 0, 0})
+	checkSlice(t, "ZScore of one value", ZScore([]float64{5}), []float64{0})
+	if got := ZScore(nil); len(got) != 0 {
+		t.Errorf("ZScore(nil) = %v, want []", got)
+	}
+}
+
+func TestMinMaxScale(t *testing.T) {
+	xs := []float64{3, 1, 5, 2}
+	checkSlice(t, "MinMaxScale(0, 1)", MinMaxScale(xs, 0, 1), []float64{0.5, 0, 1, 0.25})
+	checkSlice(t, "MinMaxScale(-1, 1)", MinMaxScale(xs, -1, 1), []float64{0, -1, 1, -0.5})
+	checkSlice(t, "MinMaxScale(1, 0)", MinMaxScale(xs, 1, 0), []float64{0.5, 1, 0, 0.75})
+	checkSlice(t, "MinMaxScale of constant", MinMaxScale([]float64{7, 7}, 0, 10), []float64{5, 5})
+
+	// The extremes map exactly to the target range.
+	ys := MinMaxScale([]float64{0.1, 0.7, 0.3}, 0.1, 0.7)
+	if ys[0] != 0.1 || ys[1] != 0.7 {
+		t.Errorf("MinMaxScale extremes = %v, %v, want 0.1, 0.7", ys[0], ys[1])
+	}
+}
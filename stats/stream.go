@@ -16,9 +16,14 @@ import (
 //
 // StreamStats should be initialized to its zero value.
 type StreamStats struct {
-	Count           uint
+	// Count is the number of values added with non-zero weight.
+	Count uint
+	// Total is the weighted sum of the values.
 	Total, Min, Max float64
 
+	// weight is the total weight of the values.
+	weight float64
+
 	// Numerically stable online mean
 	mean          float64
 	meanOfSquares float64
@@ -29,7 +34,18 @@ type StreamStats struct {
 
 // Add updates s's statistics with sample value x.
 func (s *StreamStats) Add(x float64) {
-	s.Total += x
+	s.AddWeighted(x, 1)
+}
+
+// AddWeighted updates s's statistics with sample value x with weight
+// w. Weights are frequency weights, so AddWeighted(x, 2) is
+// equivalent to adding x twice. w must be non-negative. Values with
+// zero weight are ignored.
+func (s *StreamStats) AddWeighted(x, w float64) {
+	if w == 0 {
+		return
+	}
+	s.Total += w * x
 	if s.Count == 0 {
 		s.Min, s.Max = x, x
 	} else {
@@ -41,19 +57,22 @@ func (s *StreamStats) Add(x float64) {
 		}
 	}
 	s.Count++
+	s.weight += w
 
 	// Update online mean, mean of squares, and variance.  Online
 	// variance based on Wikipedia's presentation ("Algorithms for
 	// calculating variance") of Knuth's formulation of Welford
-	// 1962.
+	// 1962, generalized to weights by West 1979.
 	delta := x - s.mean
-	s.mean += delta / float64(s.Count)
-	s.meanOfSquares += (x*x - s.meanOfSquares) / float64(s.Count)
-	s.vM2 += delta * (x - s.mean)
+	s.mean += delta * w / s.weight
+	s.meanOfSquares += (x*x - s.meanOfSquares) * w / s.weight
+	s.vM2 += w * delta * (x - s.mean)
 }
 
+// Weight returns the total weight of the values added to s. If all
+// values were added with Add, this is the same as Count.
 func (s *StreamStats) Weight() float64 {
-	return float64(s.Count)
+	return s.weight
 }
 
 func (s *StreamStats) Mean() float64 {
@@ -61,7 +80,10 @@ func (s *StreamStats) Mean() float64 {
 }
 
 func (s *StreamStats) Variance() float64 {
-	return s.vM2 / float64(s.Count-1)
+	if s.Count == 0 {
+		return 0
+	}
+	return s.vM2 / (s.weight - 1)
 }
 
 func (s *StreamStats) StdDev() float64 {
@@ -75,14 +97,21 @@ func (s *StreamStats) RMS() float64 {
 // Combine updates s's statistics as if all samples added to o were
 // added to s.
 func (s *StreamStats) Combine(o *StreamStats) {
-	count := s.Count + o.Count
+	if o.Count == 0 {
+		return
+	}
+	if s.Count == 0 {
+		*s = *o
+		return
+	}
+	weight := s.weight + o.weight
 
 	// Compute combined online variance statistics
 	delta := o.mean - s.mean
-	mean := s.mean + delta*float64(o.Count)/float64(count)
-	vM2 := s.vM2 + o.vM2 + delta*delta*float64(s.Count)*float64(o.Count)/float64(count)
+	mean := s.mean + delta*o.weight/weight
+	vM2 := s.vM2 + o.vM2 + delta*delta*s.weight*o.weight/weight
 
-	s.Count = count
+	s.Count += o.Count
 	s.Total += o.Total
 	if o.Min < s.Min {
 		s.Min = o.Min
@@ -91,7 +120,8 @@ func (s *StreamStats) Combine(o *StreamStats) {
 		s.Max = o.Max
 	}
 	s.mean = mean
-	s.meanOfSquares += (o.meanOfSquares - s.meanOfSquares) * float64(o.Count) / float64(count)
+	s.meanOfSquares += (o.meanOfSquares - s.meanOfSquares) * o.weight / weight
+	s.weight = weight
 	s.vM2 = vM2
 }
 
@@ -0,0 +1,120 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func checkStream(t *testing.T, name string, s *StreamStats, xs []float64) {
+	t.Helper()
+	check := func(what string, got, want float64) {
+		t.Helper()
+		if math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+			t.Errorf("%s: %s = %v, want %v", name, what, got, want)
+		}
+	}
+	sample := Sample{Xs: xs}
+	min, max := sample.Bounds()
+	if s.Count != uint(len(xs)) {
+		t.Errorf("%s: Count = %d, want %d", name, s.Count, len(xs))
+	}
+	check("Weight()", s.Weight(), float64(len(xs)))
+	check("Total", s.Total, sample.Sum())
+	check("Min", s.Min, min)
+	check("Max", s.Max, max)
+	check("Mean()", s.Mean(), sample.Mean())
+	check("Variance()", s.Variance(), sample.Variance())
+	check("StdDev()", s.StdDev(), sample.StdDev())
+}
+
+func TestStreamStats(t *testing.T) {
+	xs := SampleN(NormalDist{1e6, 3}, 10000, rand.New(rand.NewSource(1)))
+	var s StreamStats
+	for _, x := range xs {
+		s.Add(x)
+	}
+	checkStream(t, "Add", &s, xs)
+
+	// Combining per-shard statistics gives the same result.
+	var a, b StreamStats
+	for i, x := range xs {
+		if i%3 == 0 {
+			a.Add(x)
+		} else {
+			b.Add(x)
+		}
+	}
+	a.Combine(&b)
+	checkStream(t, "Combine", &a, xs)
+
+	// Combining into or from an empty StreamStats.
+	var empty, e2 StreamStats
+	empty.Combine(&s)
+	checkStream(t, "empty.Combine", &empty, xs)
+	s2 := s
+	s2.Combine(&e2)
+	checkStream(t, "Combine(empty)", &s2, xs)
+
+	// Negative values must set Min correctly.
+	var neg StreamStats
+	neg.Add(-2)
+	neg.Add(-5)
+	if neg.Min != -5 || neg.Max != -2 {
+		t.Errorf("Min, Max = %v, %v, want -5, -2", neg.Min, neg.Max)
+	}
+}
+
+func TestStreamStatsWeighted(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var s StreamStats
+	var xs, ws, expanded []float64
+	for i := 0; i < 1000; i++ {
+		x := 5 + r.NormFloat64()
+		w := float64(r.Intn(4))
+		s.AddWeighted(x, w)
+		xs, ws = append(xs, x), append(ws, w)
+		for j := 0; j < int(w); j++ {
+			expanded = append(expanded, x)
+		}
+	}
+
+	// With integer weights, weighting is the same as repeating
+	// each value.
+	sample := Sample{Xs: expanded}
+	check := func(what string, got, want float64) {
+		t.Helper()
+		if math.Abs(got-want) > 1e-12*math.Max(1, math.Abs(want)) {
+			t.Errorf("%s = %v, want %v", what, got, want)
+		}
+	}
+	check("Weight()", s.Weight(), float64(len(expanded)))
+	check("Total", s.Total, sample.Sum())
+	check("Mean()", s.Mean(), sample.Mean())
+	check("Mean() vs weighted Sample", s.Mean(), Sample{Xs: xs, Weights: ws}.Mean())
+	check("Variance()", s.Variance(), sample.Variance())
+	check("RMS()", s.RMS(), math.Sqrt(meanOfSquares(expanded)))
+	min, max := sample.Bounds()
+	check("Min", s.Min, min)
+	check("Max", s.Max, max)
+
+	// Zero-weight values are ignored entirely.
+	var z StreamStats
+	z.AddWeighted(100, 0)
+	z.AddWeighted(1, 1)
+	if z.Count != 1 || z.Max != 1 || z.Mean() != 1 {
+		t.Errorf("zero weight: Count=%d Max=%v Mean=%v, want 1, 1, 1", z.Count, z.Max, z.Mean())
+	}
+}
+
+func meanOfSquares(xs []float64) float64 {
+	sum := 0.0
+	for _, x := range xs {
+		sum += x * x
+	}
+	return sum / float64(len(xs))
+}
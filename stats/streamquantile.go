@@ -0,0 +1,163 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// StreamQuantile estimates a fixed set of quantiles of a stream of
+// data in O(1) space using the P² algorithm of Jain and Chlamtac
+// (1985), "The P² algorithm for dynamic calculation of quantiles and
+// histograms without storing observations".
+//
+// Each tracked quantile uses five markers (about 120 bytes), no
+// matter how many values are added. The price is accuracy: the
+// estimate is a piecewise-parabolic interpolation of the CDF, not
+// an exact order statistic. For large streams from smooth
+// distributions, the error is typically well under 1% of the
+// distribution's scale, but it is larger for extreme quantiles,
+// for small streams, and for streams whose distribution drifts
+// over time. If exact quantiles are required, store the values in
+// a Sample instead.
+//
+// StreamQuantile should be constructed with NewStreamQuantile.
+type StreamQuantile struct {
+	// Count is the number of values added.
+	Count uint
+
+	ps  []float64
+	est []p2Quantile
+	// init holds the first five values, until there are enough
+	// to initialize the markers.
+	init []float64
+}
+
+// NewStreamQuantile returns a StreamQuantile that tracks the
+// quantiles ps, each of which must be in [0, 1].
+func NewStreamQuantile(ps ...float64) *StreamQuantile {
+	s := &StreamQuantile{ps: append([]float64(nil), ps...), est: make([]p2Quantile, len(ps))}
+	for i, p := range ps {
+		if !(0 <= p && p <= 1) {
+			panic(fmt.Sprintf("quantile %v out of range [0, 1]", p))
+		}
+		s.est[i].p = p
+	}
+	return s
+}
+
+// Add updates s's estimates with sample value x.
+func (s *StreamQuantile) Add(x float64) {
+	s.Count++
+	if s.Count <= 5 {
+		s.init = append(s.init, x)
+		if s.Count == 5 {
+			sort.Float64s(s.init)
+			for i := range s.est {
+				s.est[i].start(s.init)
+			}
+		}
+		return
+	}
+	for i := range s.est {
+		s.est[i].add(x)
+	}
+}
+
+// Quantile returns the estimate of the p'th quantile of the values
+// added to s. p must be one of the quantiles passed to
+// NewStreamQuantile. If no values have been added, it returns NaN.
+//
+// Until more than five values have been added, this returns the
+// exact quantile as computed by Sample.Quantile. The 0th and 1st
+// quantiles are always the exact minimum and maximum.
+func (s *StreamQuantile) Quantile(p float64) float64 {
+	for i, tp := range s.ps {
+		if tp != p {
+			continue
+		}
+		if s.Count == 0 {
+			return nan
+		}
+		if s.Count <= 5 {
+			return Sample{Xs: s.init}.Quantile(p)
+		}
+		switch p {
+		case 0:
+			return s.est[i].q[0]
+		case 1:
+			return s.est[i].q[4]
+		}
+		return s.est[i].q[2]
+	}
+	panic(fmt.Sprintf("quantile %v is not tracked by this StreamQuantile", p))
+}
+
+// p2Quantile is the state of the P² algorithm for one quantile.
+type p2Quantile struct {
+	p float64
+	// q are the marker heights, n are the marker positions, and
+	// np are the desired marker positions.
+	q  [5]float64
+	n  [5]float64
+	np [5]float64
+}
+
+func (e *p2Quantile) start(sorted []float64) {
+	p := e.p
+	copy(e.q[:], sorted)
+	e.n = [5]float64{1, 2, 3, 4, 5}
+	e.np = [5]float64{1, 1 + 2*p, 1 + 4*p, 3 + 2*p, 5}
+}
+
+func (e *p2Quantile) add(x float64) {
+	p := e.p
+	q, n := &e.q, &e.n
+
+	// Find the cell containing x, extending the extreme
+	// markers if necessary.
+	var k int
+	switch {
+	case x < q[0]:
+		q[0] = x
+		k = 0
+	case x >= q[4]:
+		q[4] = x
+		k = 3
+	default:
+		for k = 0; k < 3 && x >= q[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		n[i]++
+	}
+	dn := [5]float64{0, p / 2, p, (1 + p) / 2, 1}
+	for i := range e.np {
+		e.np[i] += dn[i]
+	}
+
+	// Adjust the heights of the middle markers.
+	for i := 1; i <= 3; i++ {
+		d := e.np[i] - n[i]
+		if (d >= 1 && n[i+1]-n[i] > 1) || (d <= -1 && n[i-1]-n[i] < -1) {
+			d = math.Copysign(1, d)
+			// Try the piecewise-parabolic prediction,
+			// falling back to linear if it would make the
+			// heights non-monotonic.
+			qp := q[i] + d/(n[i+1]-n[i-1])*
+				((n[i]-n[i-1]+d)*(q[i+1]-q[i])/(n[i+1]-n[i])+
+					(n[i+1]-n[i]-d)*(q[i]-q[i-1])/(n[i]-n[i-1]))
+			if q[i-1] < qp && qp < q[i+1] {
+				q[i] = qp
+			} else {
+				j := i + int(d)
+				q[i] += d * (q[j] - q[i]) / (n[j] - n[i])
+			}
+			n[i] += d
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestStreamQuantile(t *testing.T) {
+	ps := []float64{0.5, 0.9, 0.99}
+	for _, d := range []Dist{NormalDist{10, 2}, ExponentialDist{Rate: 1}, LogNormalDist{0, 0.5}} {
+		r := rand.New(rand.NewSource(1))
+		xs := SampleN(d, 100000, r)
+		s := NewStreamQuantile(ps...)
+		for _, x := range xs {
+			s.Add(x)
+		}
+		exact := Sample{Xs: xs}
+		for _, p := range ps {
+			got, want := s.Quantile(p), exact.Quantile(p)
+			if math.Abs(got-want) > 0.01*math.Abs(want) {
+				t.Errorf("%+v: Quantile(%v) = %v, want %v", d, p, got, want)
+			}
+		}
+	}
+}
+
+func TestStreamQuantileSmall(t *testing.T) {
+	ps := []float64{0, 0.5, 0.9, 1}
+	s := NewStreamQuantile(ps...)
+	if got := s.Quantile(0.5); !math.IsNaN(got) {
+		t.Errorf("Quantile of empty stream = %v, want NaN", got)
+	}
+	xs := []float64{5, 1, 4, 2, 3, 7, 6}
+	for i, x := range xs {
+		s.Add(x)
+		if i >= 5 {
+			continue
+		}
+		// This includes Count == 5, when the markers have just
+		// been initialized.
+		want := Sample{Xs: xs[:i+1]}
+		for _, p := range ps {
+			if got, w := s.Quantile(p), want.Quantile(p); got != w {
+				t.Errorf("after %d values: Quantile(%v) = %v, want %v", i+1, p, got, w)
+			}
+		}
+	}
+	// The extreme markers track the exact minimum and maximum.
+	if got := s.Quantile(0); got != 1 {
+		t.Errorf("Quantile(0) = %v, want 1", got)
+	}
+	if got := s.Quantile(1); got != 7 {
+		t.Errorf("Quantile(1) = %v, want 7", got)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Quantile of untracked p did not panic")
+			}
+		}()
+		s.Quantile(0.25)
+	}()
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// An SWTestResult is the result of a Shapiro-Wilk test.
+type SWTestResult struct {
+	// N is the size of the input sample.
+	N int
+
+	// W is the Shapiro-Wilk statistic. It is in (0, 1], with
+	// values close to 1 indicating normality.
+	W float64
+
+	// P is the p-value of the test for the null hypothesis that
+	// the sample is drawn from a normal distribution.
+	P float64
+}
+
+// ShapiroWilkTest performs a Shapiro-Wilk test of the null hypothesis
+// that sample is drawn from a normal distribution with unknown mean
+// and variance.
+//
+// This uses the approximations of Royston (1992), "Approximating the
+// Shapiro-Wilk W-test for non-normality", Statistics and Computing 2:
+// 117–119, for both the coefficients of W and its p-value, as in
+// algorithm AS R94 (Royston, 1995).
+//
+// This can fail with ErrSampleSize if sample has fewer than 3 or
+// more than 5000 values, or ErrZeroVariance if all sample values are
+// equal.
+func ShapiroWilkTest(sample []float64) (*SWTestResult, error) {
+	n := len(sample)
+	if n < 3 || n > 5000 {
+		return nil, ErrSampleSize
+	}
+	xs := append([]float64(nil), sample...)
+	sort.Float64s(xs)
+	if xs[0] == xs[n-1] {
+		return nil, ErrZeroVariance
+	}
+
+	a := swCoefficients(n)
+	mean := Mean(xs)
+	num, den := 0.0, 0.0
+	for i, x := range xs {
+		num += a[i] * x
+		den += (x - mean) * (x - mean)
+	}
+	w := math.Min(num*num/den, 1)
+
+	return &SWTestResult{N: n, W: w, P: swPValue(w, n)}, nil
+}
+
+// swCoefficients returns the coefficients a of the Shapiro-Wilk W
+// statistic for a sample of size n, using Royston's approximation.
+func swCoefficients(n int) []float64 {
+	a := make([]float64, n)
+	if n == 3 {
+		a[0], a[2] = -math.Sqrt(0.5), math.Sqrt(0.5)
+		return a
+	}
+
+	// Blom's approximation to the expected normal order
+	// statistics.
+	m := make([]float64, n)
+	summ2 := 0.0
+	for i := range m {
+		m[i] = StdNormal.InvCDF((float64(i+1) - 0.375) / (float64(n) + 0.25))
+		summ2 += m[i] * m[i]
+	}
+	ssumm2 := math.Sqrt(summ2)
+	u := 1 / math.Sqrt(float64(n))
+
+	// Royston's polynomial approximations for the largest one or
+	// two coefficients. The remaining coefficients are
+	// proportional to m.
+	an := m[n-1]/ssumm2 + swPoly(swC1, u)
+	var phi float64
+	first := 1
+	if n > 5 {
+		an1 := m[n-2]/ssumm2 + swPoly(swC2, u)
+		phi = (summ2 - 2*m[n-1]*m[n-1] - 2*m[n-2]*m[n-2]) /
+			(1 - 2*an*an - 2*an1*an1)
+		a[1], a[n-2] = -an1, an1
+		first = 2
+	} else {
+		phi = (summ2 - 2*m[n-1]*m[n-1]) / (1 - 2*an*an)
+	}
+	a[0], a[n-1] = -an, an
+	sphi := math.Sqrt(phi)
+	for i := first; i < n-first; i++ {
+		a[i] = m[i] / sphi
+	}
+	return a
+}
+
+// swPValue returns the p-value of Shapiro-Wilk statistic w for a
+// sample of size n.
+func swPValue(w float64, n int) float64 {
+	if n == 3 {
+		// The exact distribution of W for n = 3.
+		p := 6 / math.Pi * (math.Asin(math.Sqrt(w)) - math.Pi/3)
+		return math.Max(0, math.Min(1, p))
+	}
+
+	// Normalize log(1-W) and use the upper tail of the normal
+	// distribution. For small n, this requires an additional
+	// transformation.
+	y := math.Log(1 - w)
+	var mu, sigma float64
+	fn := float64(n)
+	if n <= 11 {
+		gamma := swPoly(swG, fn)
+		if y >= gamma {
+			return 0
+		}
+		y = -math.Log(gamma - y)
+		mu = swPoly(swC3, fn)
+		sigma = math.Exp(swPoly(swC4, fn))
+	} else {
+		ln := math.Log(fn)
+		mu = swPoly(swC5, ln)
+		sigma = math.Exp(swPoly(swC6, ln))
+	}
+	return 1 - NormalDist{mu, sigma}.CDF(y)
+}
+
+// Polynomial coefficients from Royston (1992), in increasing order
+// of degree.
+var (
+	swC1 = []float64{0, 0.221157, -0.147981, -2.071190, 4.434685, -2.706056}
+	swC2 = []float64{0, 0.042981, -0.293762, -1.752461, 5.682633, -3.582633}
+	swC3 = []float64{0.5440, -0.39978, 0.025054, -6.714e-4}
+	swC4 = []float64{1.3822, -0.77857, 0.062767, -0.0020322}
+	swC5 = []float64{-1.5861, -0.31082, -0.083751, 0.0038915}
+	swC6 = []float64{-0.4803, -0.082676, 0.0030302}
+	swG  = []float64{-2.273, 0.459}
+)
+
+// swPoly evaluates the polynomial with coefficients c at x.
+func swPoly(c []float64, x float64) float64 {
+	y := 0.0
+	for i := len(c) - 1; i >= 0; i-- {
+		y = y*x + c[i]
+	}
+	return y
+}
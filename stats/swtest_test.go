@@ -0,0 +1,61 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestShapiroWilkTest(t *testing.T) {
+	for _, c := range []struct {
+		xs   []float64
+		w, p float64
+	}{
+		// Weights of 11 men from Shapiro and Wilk (1965). R's
+		// shapiro.test gives W = 0.78881, p = 0.006704.
+		{[]float64{148, 154, 158, 160, 161, 162, 166, 170, 182, 195, 236},
+			0.7888146948353878, 0.006703814056503055},
+		{[]float64{1, 2, 4}, 0.9642857142857146, 0.6368868450289714},
+		{[]float64{1.2, 3.5, 2.2, 0.4, 5.0}, 0.9715552044219844, 0.8851384850092489},
+		{[]float64{2.1, 3.4, 1.9, 5.6, 4.4, 3.3, 2.8, 4.0, 3.7, 2.5}, 0.9641265102212813, 0.8316994647880991},
+		{[]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			0.9603751831064349, 0.5513717430400848},
+	} {
+		res, err := ShapiroWilkTest(c.xs)
+		if err != nil {
+			t.Errorf("ShapiroWilkTest(%v): %v", c.xs, err)
+			continue
+		}
+		if res.N != len(c.xs) || !aeq(res.W, c.w) || !aeq(res.P, c.p) {
+			t.Errorf("ShapiroWilkTest(%v) = %+v, want W=%v, P=%v", c.xs, res, c.w, c.p)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	res, err := ShapiroWilkTest(SampleN(NormalDist{5, 2}, 1000, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("normal sample: P = %v, want large", res.P)
+	}
+	res, err = ShapiroWilkTest(SampleN(ExponentialDist{1}, 100, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P > 1e-4 {
+		t.Errorf("exponential sample: P = %v, want small", res.P)
+	}
+
+	for _, n := range []int{0, 2, 5001} {
+		if _, err := ShapiroWilkTest(make([]float64, n)); err != ErrSampleSize {
+			t.Errorf("ShapiroWilkTest with %d values returned error %v, want ErrSampleSize", n, err)
+		}
+	}
+	if _, err := ShapiroWilkTest([]float64{2, 2, 2, 2}); err != ErrZeroVariance {
+		t.Errorf("ShapiroWilkTest of constant sample returned error %v, want ErrZeroVariance", err)
+	}
+}
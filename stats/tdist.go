@@ -6,6 +6,7 @@ package stats
 
 import (
 	"math"
+	"math/rand"
 
 	"github.com/jgbaldwinbrown/go-moremath/mathx"
 )
@@ -37,6 +38,102 @@ func (t TDist) CDF(x float64) float64 {
 	}
 }
 
+// InvCDF returns the quantile function of t. This inverts t.CDF
+// numerically to full floating point precision.
+func (t TDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		return nan
+	} else if p == 0 {
+		return -inf
+	} else if p == 1 {
+		return inf
+	} else if p == 0.5 {
+		return 0
+	}
+
+	// Bracket x. The t-distribution has very heavy tails for
+	// small V, so expand the bracket geometrically.
+	lo, hi := 0.0, 1.0
+	if p < 0.5 {
+		lo, hi = -1, 0
+		for t.CDF(lo) >= p {
+			lo, hi = lo*2, lo
+			if math.IsInf(lo, -1) {
+				return -inf
+			}
+		}
+	} else {
+		for t.CDF(hi) < p {
+			lo, hi = hi, hi*2
+			if math.IsInf(hi, 1) {
+				return inf
+			}
+		}
+	}
+	_, x = bisectBool(func(x float64) bool {
+		return t.CDF(x) < p
+	}, lo, hi, 0)
+	return x
+}
+
+// Rand returns a random sample drawn from t as Z / sqrt(W / V),
+// where Z is standard normal and W is chi-squared with V degrees of
+// freedom.
+func (t TDist) Rand(r *rand.Rand) float64 {
+	var z float64
+	if r == nil {
+		z = rand.NormFloat64()
+	} else {
+		z = r.NormFloat64()
+	}
+	w := ChiSquaredDist{t.V}.Rand(r)
+	return z / math.Sqrt(w/t.V)
+}
+
 func (t TDist) Bounds() (float64, float64) {
 	return -4, 4
 }
+
+// Mean returns the mean of t, which is 0 if V > 1 and undefined (NaN)
+// otherwise.
+func (t TDist) Mean() float64 {
+	if t.V > 1 {
+		return 0
+	}
+	return nan
+}
+
+// Variance returns the variance of t. This is +Inf if 1 < V <= 2 and
+// undefined (NaN) if V <= 1.
+func (t TDist) Variance() float64 {
+	if t.V > 2 {
+		return t.V / (t.V - 2)
+	} else if t.V > 1 {
+		return inf
+	}
+	return nan
+}
+
+// Skewness returns the skewness of t, which is 0 if V > 3 and
+// undefined (NaN) otherwise.
+func (t TDist) Skewness() float64 {
+	if t.V > 3 {
+		return 0
+	}
+	return nan
+}
+
+// ExcessKurtosis returns the excess kurtosis of t. This is +Inf if
+// 2 < V <= 4 and undefined (NaN) if V <= 2.
+func (t TDist) ExcessKurtosis() float64 {
+	if t.V > 4 {
+		return 6 / (t.V - 4)
+	} else if t.V > 2 {
+		return inf
+	}
+	return nan
+}
+
+func (t TDist) Median() float64 {
+	return 0
+}
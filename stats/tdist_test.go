@@ -4,7 +4,10 @@
 
 package stats
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestT(t *testing.T) {
 	testFunc(t, "PDF(%v|v=1)", TDist{1}.PDF, map[float64]float64{
@@ -93,3 +96,34 @@ func TestT(t *testing.T) {
 		8:   0.99975354666971372,
 		9:   0.9998586600128780})
 }
+
+func TestTInvCDF(t *testing.T) {
+	ps := []float64{0.001, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 0.75, 0.9, 0.95, 0.975, 0.99, 0.999}
+	for _, v := range []float64{1, 2, 5, 10, 30, 1000} {
+		dist := TDist{v}
+		for _, p := range ps {
+			x := dist.InvCDF(p)
+			if got := dist.CDF(x); math.Abs(got-p) > 1e-10 {
+				t.Errorf("%+v.CDF(InvCDF(%v)) = %v", dist, p, got)
+			}
+		}
+		testInvCDF(t, dist, false)
+	}
+
+	// With V=1, this is the standard Cauchy distribution.
+	cauchy := CauchyDist{X0: 0, Gamma: 1}
+	for _, p := range ps {
+		if got, want := (TDist{1}).InvCDF(p), cauchy.InvCDF(p); math.Abs(got-want) > 1e-9*math.Max(1, math.Abs(want)) {
+			t.Errorf("TDist{1}.InvCDF(%v) = %v, want %v", p, got, want)
+		}
+	}
+
+	// Tabulated two-sided critical values.
+	testFunc(t, "InvCDF(0.975|v=%v)", func(v float64) float64 {
+		return TDist{v}.InvCDF(0.975)
+	}, map[float64]float64{
+		1:  12.706204736174698,
+		5:  2.5705818356363146,
+		10: 2.2281388519862744,
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math/rand"
+
+// TheilSen fits the line y = intercept + slope*x to the points (x[i],
+// y[i]) using the Theil-Sen estimator. The slope is the median of the
+// slopes between all pairs of points with distinct x values, and the
+// intercept is the median of y[i] - slope*x[i].
+//
+// Unlike least squares, this is robust to outliers: up to about 29%
+// of the points can be arbitrarily corrupted without arbitrarily
+// changing the slope. It takes O(n²) time and space; for large n,
+// see TheilSenSubsample.
+//
+// This fails with ErrMismatchedSamples if x and y have different
+// lengths, or ErrSampleSize if there are not two distinct x values.
+func TheilSen(x, y []float64) (slope, intercept float64, err error) {
+	if len(x) != len(y) {
+		return 0, 0, ErrMismatchedSamples
+	}
+	var slopes []float64
+	for i := range x {
+		for j := i + 1; j < len(x); j++ {
+			if x[i] != x[j] {
+				slopes = append(slopes, (y[j]-y[i])/(x[j]-x[i]))
+			}
+		}
+	}
+	return theilSenFinish(x, y, slopes)
+}
+
+// TheilSenSubsample is like TheilSen, but estimates the slope from
+// the median of the slopes between the given number of randomly
+// chosen pairs of points, rather than all pairs. This takes
+// O(n + pairs log pairs) time, so it is practical for large n, at the
+// cost of some additional variability in the estimate.
+//
+// If r is nil, TheilSenSubsample uses the default Source in
+// math/rand.
+func TheilSenSubsample(x, y []float64, pairs int, r *rand.Rand) (slope, intercept float64, err error) {
+	if len(x) != len(y) {
+		return 0, 0, ErrMismatchedSamples
+	}
+	if len(x) < 2 {
+		return 0, 0, ErrSampleSize
+	}
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+	slopes := make([]float64, 0, pairs)
+	for k := 0; k < pairs; k++ {
+		i, j := intn(len(x)), intn(len(x)-1)
+		if j >= i {
+			// Choose j != i uniformly.
+			j++
+		}
+		if x[i] != x[j] {
+			slopes = append(slopes, (y[j]-y[i])/(x[j]-x[i]))
+		}
+	}
+	return theilSenFinish(x, y, slopes)
+}
+
+func theilSenFinish(x, y, slopes []float64) (slope, intercept float64, err error) {
+	if len(slopes) == 0 {
+		return 0, 0, ErrSampleSize
+	}
+	slope = Sample{Xs: slopes}.Quantile(0.5)
+	resid := make([]float64, len(x))
+	for i := range x {
+		resid[i] = y[i] - slope*x[i]
+	}
+	intercept = Sample{Xs: resid}.Quantile(0.5)
+	return slope, intercept, nil
+}
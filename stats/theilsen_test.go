@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestTheilSen(t *testing.T) {
+	// Exact line.
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{3, 5, 7, 9, 11}
+	if slope, intercept, err := TheilSen(x, y); err != nil || slope != 2 || intercept != 1 {
+		t.Errorf("TheilSen(2x+1) = %v, %v, %v, want 2, 1, nil", slope, intercept, err)
+	}
+
+	// A noisy line with a few gross outliers.
+	r := rand.New(rand.NewSource(1))
+	const n = 50
+	x, y = make([]float64, n), make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+		y[i] = 0.5*x[i] + 10 + 0.1*r.NormFloat64()
+	}
+	y[5], y[20], y[45] = 1000, -500, 2000
+	slope, intercept, err := TheilSen(x, y)
+	if err != nil || math.Abs(slope-0.5) > 0.01 || math.Abs(intercept-10) > 0.1 {
+		t.Errorf("TheilSen with outliers = %v, %v, %v, want ≈0.5, ≈10", slope, intercept, err)
+	}
+	// Least squares is wrecked by the same outliers.
+	mx, my := Mean(x), Mean(y)
+	sxy, sxx := 0.0, 0.0
+	for i := range x {
+		sxy += (x[i] - mx) * (y[i] - my)
+		sxx += (x[i] - mx) * (x[i] - mx)
+	}
+	if ols := sxy / sxx; math.Abs(ols-0.5) < 1 {
+		t.Errorf("OLS slope with outliers = %v; test outliers are not gross enough", ols)
+	}
+
+	// The subsampled estimate is close.
+	slope, intercept, err = TheilSenSubsample(x, y, 2000, r)
+	if err != nil || math.Abs(slope-0.5) > 0.02 || math.Abs(intercept-10) > 0.3 {
+		t.Errorf("TheilSenSubsample with outliers = %v, %v, %v, want ≈0.5, ≈10", slope, intercept, err)
+	}
+
+	// Pairs with equal x are ignored, so the slopes are
+	// (1-0)/1 = 1 and (1-5)/1 = -4, and the median is -1.5.
+	if slope, _, err := TheilSen([]float64{1, 1, 2}, []float64{0, 5, 1}); err != nil || slope != -1.5 {
+		t.Errorf("TheilSen with tied x: slope = %v, %v, want -1.5", slope, err)
+	}
+
+	if _, _, err := TheilSen([]float64{1, 2}, []float64{1}); err != ErrMismatchedSamples {
+		t.Errorf("TheilSen of mismatched lengths: err = %v, want %v", err, ErrMismatchedSamples)
+	}
+	for _, x := range [][]float64{{}, {1}, {2, 2, 2}} {
+		if _, _, err := TheilSen(x, make([]float64, len(x))); err != ErrSampleSize {
+			t.Errorf("TheilSen(%v): err = %v, want %v", x, err, ErrSampleSize)
+		}
+	}
+	if _, _, err := TheilSenSubsample([]float64{1}, []float64{1}, 10, r); err != ErrSampleSize {
+		t.Errorf("TheilSenSubsample of one point: err = %v, want %v", err, ErrSampleSize)
+	}
+}
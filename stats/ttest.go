@@ -6,6 +6,7 @@ package stats
 
 import (
 	"errors"
+	"fmt"
 	"math"
 )
 
@@ -28,9 +29,22 @@ type TTestResult struct {
 
 	// P is p-value for this t-test for the given null hypothesis.
 	P float64
+
+	// D is Cohen's d, the difference in means in units of
+	// standard deviation. For two-sample tests, this uses the
+	// pooled standard deviation (even for Welch's t-test). For
+	// paired tests, it is the mean difference less μ0 divided by
+	// the standard deviation of the differences, and for
+	// one-sample tests it is the mean less μ0 divided by the
+	// sample standard deviation.
+	D float64
+}
+
+func (r TTestResult) String() string {
+	return fmt.Sprintf("N1=%d N2=%d T=%.4g DoF=%.4g P=%.4g D=%.4g AltHypothesis=%v", r.N1, r.N2, r.T, r.DoF, r.P, r.D, r.AltHypothesis)
 }
 
-func newTTestResult(n1, n2 int, t, dof float64, alt LocationHypothesis) *TTestResult {
+func newTTestResult(n1, n2 int, t, dof, d float64, alt LocationHypothesis) *TTestResult {
 	dist := TDist{dof}
 	var p float64
 	switch alt {
@@ -41,7 +55,7 @@ func newTTestResult(n1, n2 int, t, dof float64, alt LocationHypothesis) *TTestRe
 	case LocationGreater:
 		p = 1 - dist.CDF(t)
 	}
-	return &TTestResult{N1: n1, N2: n2, T: t, DoF: dof, AltHypothesis: alt, P: p}
+	return &TTestResult{N1: n1, N2: n2, T: t, DoF: dof, AltHypothesis: alt, P: p, D: d}
 }
 
 // A TTestSample is a sample that can be used for a one or two sample
@@ -76,7 +90,8 @@ func TwoSampleTTest(x1, x2 TTestSample, alt LocationHypothesis) (*TTestResult, e
 	dof := n1 + n2 - 2
 	v12 := ((n1-1)*v1 + (n2-1)*v2) / dof
 	t := (x1.Mean() - x2.Mean()) / math.Sqrt(v12*(1/n1+1/n2))
-	return newTTestResult(int(n1), int(n2), t, dof, alt), nil
+	d := (x1.Mean() - x2.Mean()) / math.Sqrt(v12)
+	return newTTestResult(int(n1), int(n2), t, dof, d, alt), nil
 }
 
 // TwoSampleWelchTTest performs a two-sample (unpaired) Welch's t-test
@@ -97,7 +112,8 @@ func TwoSampleWelchTTest(x1, x2 TTestSample, alt LocationHypothesis) (*TTestResu
 		(math.Pow(v1/n1, 2)/(n1-1) + math.Pow(v2/n2, 2)/(n2-1))
 	s := math.Sqrt(v1/n1 + v2/n2)
 	t := (x1.Mean() - x2.Mean()) / s
-	return newTTestResult(int(n1), int(n2), t, dof, alt), nil
+	d := (x1.Mean() - x2.Mean()) / pooledStdDev(n1, v1, n2, v2)
+	return newTTestResult(int(n1), int(n2), t, dof, d, alt), nil
 }
 
 // PairedTTest performs a two-sample paired t-test on samples x1 and
@@ -125,7 +141,8 @@ func PairedTTest(x1, x2 []float64, μ0 float64, alt LocationHypothesis) (*TTestR
 		return nil, ErrZeroVariance
 	}
 	t := (Mean(diff) - μ0) * math.Sqrt(float64(len(x1))) / sd
-	return newTTestResult(len(x1), len(x2), t, dof, alt), nil
+	d := (Mean(diff) - μ0) / sd
+	return newTTestResult(len(x1), len(x2), t, dof, d, alt), nil
 }
 
 // OneSampleTTest performs a one-sample t-test on sample x. This tests
@@ -143,5 +160,38 @@ func OneSampleTTest(x TTestSample, μ0 float64, alt LocationHypothesis) (*TTestR
 	}
 	dof := n - 1
 	t := (x.Mean() - μ0) * math.Sqrt(n) / math.Sqrt(v)
-	return newTTestResult(int(n), 0, t, dof, alt), nil
+	d := (x.Mean() - μ0) / math.Sqrt(v)
+	return newTTestResult(int(n), 0, t, dof, d, alt), nil
+}
+
+// pooledStdDev returns the pooled standard deviation of two samples
+// with weights n1 and n2 and variances v1 and v2.
+func pooledStdDev(n1, v1, n2, v2 float64) float64 {
+	return math.Sqrt(((n1-1)*v1 + (n2-1)*v2) / (n1 + n2 - 2))
+}
+
+// CohensD returns Cohen's d effect size for samples x1 and x2: the
+// difference of their means divided by their pooled standard
+// deviation. This is the same as the D field of the result of
+// TwoSampleTTest.
+//
+// If x1 and x2 have fewer than 3 values in total, or both have zero
+// variance, CohensD returns NaN.
+func CohensD(x1, x2 []float64) float64 {
+	n1, n2 := float64(len(x1)), float64(len(x2))
+	if n1+n2 <= 2 {
+		return nan
+	}
+	v1, v2 := 0.0, 0.0
+	if n1 > 1 {
+		v1 = Variance(x1)
+	}
+	if n2 > 1 {
+		v2 = Variance(x2)
+	}
+	sp := pooledStdDev(n1, v1, n2, v2)
+	if sp == 0 {
+		return nan
+	}
+	return (Mean(x1) - Mean(x2)) / sp
 }
@@ -4,7 +4,10 @@
 
 package stats
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestTTest(t *testing.T) {
 	s1 := Sample{Xs: []float64{2, 1, 3, 4}}
@@ -69,3 +72,38 @@ func TestTTest(t *testing.T) {
 	}, 4, 0, 0, 3,
 		0.5, 1, 0.5)
 }
+
+func TestCohensD(t *testing.T) {
+	// Both samples have unit variance and their means differ by 0.5.
+	x1 := []float64{-0.5, 0.5, 1.5}
+	x2 := []float64{-1, 0, 1}
+	if d := CohensD(x1, x2); !aeq(d, 0.5) {
+		t.Errorf("CohensD(%v, %v) = %v, want 0.5", x1, x2, d)
+	}
+	if d := CohensD(x2, x1); !aeq(d, -0.5) {
+		t.Errorf("CohensD(%v, %v) = %v, want -0.5", x2, x1, d)
+	}
+	if d := CohensD([]float64{1, 1}, []float64{2, 2}); !math.IsNaN(d) {
+		t.Errorf("CohensD with zero variance = %v, want NaN", d)
+	}
+
+	s1, s2 := Sample{Xs: x1}, Sample{Xs: x2}
+	check := func(name string, r *TTestResult, err error, want float64) {
+		t.Helper()
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		} else if !aeq(r.D, want) {
+			t.Errorf("%s: D = %v, want %v", name, r.D, want)
+		}
+	}
+	r, err := TwoSampleTTest(s1, s2, LocationDiffers)
+	check("TwoSampleTTest", r, err, 0.5)
+	r, err = TwoSampleWelchTTest(s1, s2, LocationDiffers)
+	check("TwoSampleWelchTTest", r, err, 0.5)
+	r, err = OneSampleTTest(s2, -0.5, LocationDiffers)
+	check("OneSampleTTest", r, err, 0.5)
+	// The differences are {1, 1, 2}, with mean 4/3 and standard
+	// deviation 1/√3.
+	r, err = PairedTTest([]float64{1, 2, 3}, []float64{0, 1, 1}, 0, LocationDiffers)
+	check("PairedTTest", r, err, 4/math.Sqrt(3))
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+// A TTest is a configured t-test that can be run on many data sets.
+// It is an alternative to calling TwoSampleTTest,
+// TwoSampleWelchTTest, PairedTTest, and OneSampleTTest directly.
+//
+// A TTest should be constructed with NewTTest.
+type TTest struct {
+	alt      LocationHypothesis
+	equalVar bool
+	paired   bool
+	μ0       float64
+}
+
+// A TTestOption configures a TTest.
+type TTestOption func(*TTest)
+
+// NewTTest returns a TTest configured by opts. By default, it is a
+// two-tailed, unpaired Welch's t-test (which does not assume equal
+// variances) with μ0 = 0. Options are applied in order, so later
+// options override earlier ones.
+func NewTTest(opts ...TTestOption) *TTest {
+	t := &TTest{alt: LocationDiffers}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// WithAlternative sets the alternative hypothesis of the test.
+func WithAlternative(alt LocationHypothesis) TTestOption {
+	return func(t *TTest) { t.alt = alt }
+}
+
+// WithEqualVariance sets whether a two-sample test assumes the two
+// populations have equal variances. If true, the test is Student's
+// t-test (TwoSampleTTest); otherwise, it is Welch's t-test
+// (TwoSampleWelchTTest).
+func WithEqualVariance(equal bool) TTestOption {
+	return func(t *TTest) { t.equalVar = equal }
+}
+
+// WithPaired sets whether a two-sample test is a paired t-test
+// (PairedTTest). If true, WithEqualVariance has no effect.
+func WithPaired(paired bool) TTestOption {
+	return func(t *TTest) { t.paired = paired }
+}
+
+// WithMu0 sets the mean (or, for a paired test, the mean difference)
+// under the null hypothesis of a one-sample or paired test. It has
+// no effect on unpaired two-sample tests.
+func WithMu0(μ0 float64) TTestOption {
+	return func(t *TTest) { t.μ0 = μ0 }
+}
+
+// Run performs the configured test on x1 and x2. If x2 is nil, it
+// performs a one-sample test of x1 against μ0.
+func (t *TTest) Run(x1, x2 []float64) (*TTestResult, error) {
+	switch {
+	case x2 == nil:
+		return OneSampleTTest(Sample{Xs: x1}, t.μ0, t.alt)
+	case t.paired:
+		return PairedTTest(x1, x2, t.μ0, t.alt)
+	case t.equalVar:
+		return TwoSampleTTest(Sample{Xs: x1}, Sample{Xs: x2}, t.alt)
+	}
+	return TwoSampleWelchTTest(Sample{Xs: x1}, Sample{Xs: x2}, t.alt)
+}
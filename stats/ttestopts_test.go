@@ -0,0 +1,68 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTTestOptions(t *testing.T) {
+	x1 := []float64{1, 2, 3, 4, 6}
+	x2 := []float64{3, 4, 5, 6, 9}
+	s1, s2 := Sample{Xs: x1}, Sample{Xs: x2}
+
+	check := func(name string, tt *TTest, x2 []float64, want *TTestResult) {
+		t.Helper()
+		got, err := tt.Run(x1, x2)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: Run = %v, want %v", name, got, want)
+		}
+	}
+	must := func(r *TTestResult, err error) *TTestResult {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+
+	check("default", NewTTest(), x2, must(TwoSampleWelchTTest(s1, s2, LocationDiffers)))
+	check("equal variance", NewTTest(WithEqualVariance(true)), x2, must(TwoSampleTTest(s1, s2, LocationDiffers)))
+	check("equal variance, less",
+		NewTTest(WithAlternative(LocationLess), WithEqualVariance(true)), x2,
+		must(TwoSampleTTest(s1, s2, LocationLess)))
+	check("Welch, greater",
+		NewTTest(WithAlternative(LocationGreater), WithEqualVariance(false)), x2,
+		must(TwoSampleWelchTTest(s1, s2, LocationGreater)))
+	check("paired",
+		NewTTest(WithPaired(true), WithEqualVariance(true), WithMu0(-1)), x2,
+		must(PairedTTest(x1, x2, -1, LocationDiffers)))
+	check("one sample",
+		NewTTest(WithMu0(2), WithAlternative(LocationGreater)), nil,
+		must(OneSampleTTest(s1, 2, LocationGreater)))
+
+	// Later options override earlier ones.
+	check("override",
+		NewTTest(WithAlternative(LocationLess), WithEqualVariance(true), WithAlternative(LocationGreater), WithEqualVariance(false)), x2,
+		must(TwoSampleWelchTTest(s1, s2, LocationGreater)))
+
+	// A configured test can be reused.
+	tt := NewTTest(WithAlternative(LocationLess))
+	for _, ys := range [][]float64{x2, {0, 1, 2}, {10, 11, 13}} {
+		got, err := tt.Run(x1, ys)
+		want, wantErr := TwoSampleWelchTTest(s1, Sample{Xs: ys}, LocationLess)
+		if err != wantErr || !reflect.DeepEqual(got, want) {
+			t.Errorf("reused Run(%v) = %v, %v, want %v, %v", ys, got, err, want, wantErr)
+		}
+	}
+
+	if _, err := NewTTest(WithPaired(true)).Run(x1, x2[:3]); err != ErrMismatchedSamples {
+		t.Errorf("paired Run with mismatched samples: err = %v, want %v", err, ErrMismatchedSamples)
+	}
+}
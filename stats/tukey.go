@@ -0,0 +1,65 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// A PairwiseResult is the result of comparing one pair of groups in
+// a post-hoc test such as TukeyHSD.
+type PairwiseResult struct {
+	// I and J are the indexes of the two groups compared, with
+	// I < J.
+	I, J int
+
+	// Diff is the difference in means, mean(J) - mean(I).
+	Diff float64
+
+	// Lo and Hi are the bounds of the simultaneous confidence
+	// interval for Diff.
+	Lo, Hi float64
+
+	// P is the p-value for the null hypothesis that the two
+	// groups have equal means, adjusted for multiple comparisons.
+	P float64
+}
+
+// TukeyHSD performs Tukey's honestly significant difference test,
+// comparing the means of every pair of groups. This is typically used
+// after OneWayANOVA rejects the hypothesis that all means are equal
+// to find which means differ. It makes the same assumptions as
+// OneWayANOVA. For groups of unequal sizes, this is the Tukey-Kramer
+// method.
+//
+// The confidence intervals have simultaneous coverage 1-alpha, and
+// the p-values are adjusted for the k(k-1)/2 comparisons of k groups
+// using StudentizedRangeDist. The results are in order
+// of I, then J.
+//
+// This fails with the same errors as OneWayANOVA.
+func TukeyHSD(groups [][]float64, alpha float64) ([]PairwiseResult, error) {
+	a, err := OneWayANOVA(groups...)
+	if err != nil {
+		return nil, err
+	}
+	dist := StudentizedRangeDist{K: len(groups), DF: float64(a.DFWithin)}
+	mse := a.SSWithin / dist.DF
+	qcrit := dist.InvCDF(1 - alpha)
+
+	var res []PairwiseResult
+	for i := range groups {
+		for j := i + 1; j < len(groups); j++ {
+			diff := a.GroupMeans[j] - a.GroupMeans[i]
+			se := math.Sqrt(mse / 2 * (1/float64(len(groups[i])) + 1/float64(len(groups[j]))))
+			res = append(res, PairwiseResult{
+				I: i, J: j,
+				Diff: diff,
+				Lo:   diff - qcrit*se,
+				Hi:   diff + qcrit*se,
+				P:    1 - dist.CDF(math.Abs(diff)/se),
+			})
+		}
+	}
+	return res, nil
+}
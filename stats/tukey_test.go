@@ -0,0 +1,43 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTukeyHSD(t *testing.T) {
+	// R's PlantGrowth data set. The expected results are from
+	// TukeyHSD(aov(weight ~ group, data = PlantGrowth)).
+	ctrl := []float64{4.17, 5.58, 5.18, 6.11, 4.50, 4.61, 5.17, 4.53, 5.33, 5.14}
+	trt1 := []float64{4.81, 4.17, 4.41, 3.59, 5.87, 3.83, 6.03, 4.89, 4.32, 4.69}
+	trt2 := []float64{6.31, 5.12, 5.54, 5.50, 5.37, 5.29, 4.92, 6.15, 5.80, 5.26}
+	res, err := TukeyHSD([][]float64{ctrl, trt1, trt2}, 0.05)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []PairwiseResult{
+		{0, 1, -0.371, -1.0622161, 0.3202161, 0.3908711},
+		{0, 2, 0.494, -0.1972161, 1.1852161, 0.1979960},
+		{1, 2, 0.865, 0.1737839, 1.5562161, 0.0120064},
+	}
+	if len(res) != len(want) {
+		t.Fatalf("TukeyHSD returned %d results, want %d", len(res), len(want))
+	}
+	for i, w := range want {
+		g := res[i]
+		if g.I != w.I || g.J != w.J ||
+			math.Abs(g.Diff-w.Diff) > 1e-9 ||
+			math.Abs(g.Lo-w.Lo) > 1e-6 || math.Abs(g.Hi-w.Hi) > 1e-6 ||
+			math.Abs(g.P-w.P) > 1e-6 {
+			t.Errorf("result %d = %+v, want %+v", i, g, w)
+		}
+	}
+
+	if _, err := TukeyHSD([][]float64{ctrl}, 0.05); err != ErrSampleSize {
+		t.Errorf("TukeyHSD of one group: err = %v, want %v", err, ErrSampleSize)
+	}
+}
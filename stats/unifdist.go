@@ -0,0 +1,103 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// UniformDist is a continuous uniform distribution over [Lo, Hi].
+//
+// Hi must be greater than Lo. If it is not, all methods of UniformDist
+// return NaN.
+type UniformDist struct {
+	Lo, Hi float64
+}
+
+// ok returns whether d's parameters are valid. This is false if
+// either parameter is NaN.
+func (d UniformDist) ok() bool {
+	return d.Lo < d.Hi
+}
+
+func (d UniformDist) PDF(x float64) float64 {
+	if !d.ok() {
+		return nan
+	}
+	if x < d.Lo || x > d.Hi {
+		return 0
+	}
+	return 1 / (d.Hi - d.Lo)
+}
+
+func (d UniformDist) CDF(x float64) float64 {
+	if !d.ok() {
+		return nan
+	}
+	if x <= d.Lo {
+		return 0
+	} else if x >= d.Hi {
+		return 1
+	}
+	return (x - d.Lo) / (d.Hi - d.Lo)
+}
+
+func (d UniformDist) InvCDF(p float64) (x float64) {
+	if !d.ok() || p < 0 || p > 1 {
+		return nan
+	} else if p == 1 {
+		// Avoid round-off in the general formula.
+		return d.Hi
+	}
+	return d.Lo + p*(d.Hi-d.Lo)
+}
+
+func (d UniformDist) Rand(r *rand.Rand) float64 {
+	if !d.ok() {
+		return nan
+	}
+	var u float64
+	if r == nil {
+		u = rand.Float64()
+	} else {
+		u = r.Float64()
+	}
+	return d.Lo + u*(d.Hi-d.Lo)
+}
+
+func (d UniformDist) Bounds() (float64, float64) {
+	if !d.ok() {
+		return nan, nan
+	}
+	return d.Lo, d.Hi
+}
+
+func (d UniformDist) Mean() float64 {
+	if !d.ok() {
+		return nan
+	}
+	return (d.Lo + d.Hi) / 2
+}
+
+func (d UniformDist) Variance() float64 {
+	if !d.ok() {
+		return nan
+	}
+	w := d.Hi - d.Lo
+	return w * w / 12
+}
+
+func (d UniformDist) Median() float64 {
+	return d.Mean()
+}
+
+// Entropy returns the differential entropy of d in nats.
+func (d UniformDist) Entropy() float64 {
+	if !d.ok() {
+		return nan
+	}
+	return math.Log(d.Hi - d.Lo)
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestUniformDist(t *testing.T) {
+	d := UniformDist{Lo: -1, Hi: 3}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-2: 0,
+		-1: 0.25,
+		0:  0.25,
+		3:  0.25,
+		4:  0,
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-2: 0,
+		-1: 0,
+		0:  0.25,
+		1:  0.5,
+		3:  1,
+		4:  1,
+	})
+	testInvCDF(t, d, true)
+	for _, p := range vec.Linspace(0, 1, 21) {
+		if got := d.CDF(d.InvCDF(p)); !aeq(p, got) {
+			t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+		}
+	}
+	if m := d.Mean(); m != 1 {
+		t.Errorf("%+v.Mean() = %v, want 1", d, m)
+	}
+	if v := d.Variance(); !aeq(v, 16.0/12) {
+		t.Errorf("%+v.Variance() = %v, want %v", d, v, 16.0/12)
+	}
+	for i := 0; i < 100; i++ {
+		if x := d.Rand(nil); x < d.Lo || x > d.Hi {
+			t.Fatalf("%+v.Rand() = %v, out of support", d, x)
+		}
+	}
+}
+
+func TestUniformDistInvalid(t *testing.T) {
+	for _, d := range []UniformDist{{1, 1}, {2, 1}, {nan, 1}} {
+		if got := d.PDF(1); !math.IsNaN(got) {
+			t.Errorf("%+v.PDF(1) = %v, want NaN", d, got)
+		}
+		if got := d.CDF(1); !math.IsNaN(got) {
+			t.Errorf("%+v.CDF(1) = %v, want NaN", d, got)
+		}
+		if got := d.InvCDF(0.5); !math.IsNaN(got) {
+			t.Errorf("%+v.InvCDF(0.5) = %v, want NaN", d, got)
+		}
+	}
+}
+
+func TestUniformEntropy(t *testing.T) {
+	for _, d := range []UniformDist{{0, 1}, {-2, 6}, {0, 0.25}} {
+		want := math.Log(d.Hi - d.Lo)
+		if got := d.Entropy(); !aeq(want, got) {
+			t.Errorf("%+v.Entropy() = %v, want %v", d, got, want)
+		}
+		testEntropy(t, d, d.Lo, d.Hi)
+	}
+	if got := (UniformDist{1, 1}).Entropy(); !math.IsNaN(got) {
+		t.Errorf("invalid UniformDist Entropy() = %v, want NaN", got)
+	}
+}
@@ -5,6 +5,7 @@
 package stats
 
 import (
+	"fmt"
 	"math"
 	"sort"
 
@@ -73,6 +74,10 @@ type MannWhitneyUTestResult struct {
 	P float64
 }
 
+func (r MannWhitneyUTestResult) String() string {
+	return fmt.Sprintf("N1=%d N2=%d U=%g P=%.4g AltHypothesis=%v", r.N1, r.N2, r.U, r.P, r.AltHypothesis)
+}
+
 // MannWhitneyExactLimit gives the largest sample size for which the
 // exact U distribution will be used for the Mann-Whitney U-test.
 //
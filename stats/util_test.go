@@ -6,6 +6,7 @@ package stats
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/jgbaldwinbrown/go-moremath/internal/mathtest"
@@ -30,6 +31,36 @@ func testDiscreteCDF(t *testing.T, name string, dist DiscreteDist) {
 	testFunc(t, name, dist.CDF, want)
 }
 
+// simpson returns the integral of f over [lo, hi] computed using the
+// composite Simpson's rule with n subintervals. n must be even.
+func simpson(f func(float64) float64, lo, hi float64, n int) float64 {
+	h := (hi - lo) / float64(n)
+	sum := f(lo) + f(hi)
+	for j := 1; j < n; j++ {
+		if j%2 == 1 {
+			sum += 4 * f(lo+float64(j)*h)
+		} else {
+			sum += 2 * f(lo+float64(j)*h)
+		}
+	}
+	return sum * h / 3
+}
+
+// testPDFIntegral checks that the CDF of dist over each interval
+// between consecutive points in xs agrees with the integral of its
+// PDF over that interval, computed using Simpson's rule.
+func testPDFIntegral(t *testing.T, dist Dist, xs []float64) {
+	t.Helper()
+	for i := 1; i < len(xs); i++ {
+		lo, hi := xs[i-1], xs[i]
+		got := simpson(dist.PDF, lo, hi, 1000)
+		want := dist.CDF(hi) - dist.CDF(lo)
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("%+v: ∫PDF over [%v, %v] = %v, but CDF difference = %v", dist, lo, hi, got, want)
+		}
+	}
+}
+
 func testInvCDF(t *testing.T, dist Dist, bounded bool) {
 	inv := InvCDF(dist)
 	name := fmt.Sprintf("InvCDF(%+v)", dist)
@@ -73,3 +104,23 @@ func testInvCDF(t *testing.T, dist Dist, bounded bool) {
 		},
 		vals)
 }
+
+// testEntropy checks that the differential entropy of dist agrees
+// with the integral of -PDF(x)*log(PDF(x)) over [lo, hi], computed
+// using Simpson's rule.
+func testEntropy(t *testing.T, dist interface {
+	Dist
+	Entropy() float64
+}, lo, hi float64) {
+	t.Helper()
+	want := simpson(func(x float64) float64 {
+		p := dist.PDF(x)
+		if p <= 0 {
+			return 0
+		}
+		return -p * math.Log(p)
+	}, lo, hi, 100000)
+	if got := dist.Entropy(); math.Abs(got-want) > 1e-6 {
+		t.Errorf("%+v.Entropy() = %v, but numerical integral = %v", dist, got, want)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import "math"
+
+// A LeveneTestResult is the result of a Levene test.
+type LeveneTestResult struct {
+	// W is the Levene test statistic, which is F-distributed
+	// under the null hypothesis.
+	W float64
+
+	// DF1 and DF2 are the degrees of freedom of the F
+	// distribution used to compute P. These are k-1 and N-k for k
+	// groups and N total values.
+	DF1, DF2 int
+
+	// P is the p-value of the test for the null hypothesis that
+	// all groups have equal variance.
+	P float64
+}
+
+// LeveneTest performs the Brown-Forsythe variant of Levene's test of
+// the null hypothesis that groups are all drawn from populations with
+// equal variance. This is a one-way ANOVA of the absolute deviations
+// of each value from its group median. Using the median rather than
+// the mean makes the test robust to non-normal data.
+//
+// This can be used to choose between TwoSampleTTest, which assumes
+// equal variance, and TwoSampleWelchTTest.
+//
+// This can fail with the same errors as OneWayANOVA.
+func LeveneTest(groups ...[]float64) (*LeveneTestResult, error) {
+	devs := make([][]float64, len(groups))
+	for i, g := range groups {
+		med := Sample{Xs: g}.Quantile(0.5)
+		devs[i] = make([]float64, len(g))
+		for j, x := range g {
+			devs[i][j] = math.Abs(x - med)
+		}
+	}
+	res, err := OneWayANOVA(devs...)
+	if err != nil {
+		return nil, err
+	}
+	return &LeveneTestResult{W: res.F, DF1: res.DFBetween, DF2: res.DFWithin, P: res.P}, nil
+}
+
+// A BartlettTestResult is the result of a Bartlett test.
+type BartlettTestResult struct {
+	// K2 is Bartlett's test statistic, which is approximately
+	// chi-squared distributed under the null hypothesis.
+	K2 float64
+
+	// DoF is the degrees of freedom of the chi-squared
+	// distribution used to compute P. This is one less than the
+	// number of groups.
+	DoF int
+
+	// P is the p-value of the test for the null hypothesis that
+	// all groups have equal variance.
+	P float64
+}
+
+// BartlettTest performs Bartlett's test of the null hypothesis that
+// groups are all drawn from normal populations with equal variance.
+// This is more powerful than LeveneTest for normal data, but is
+// sensitive to departures from normality.
+//
+// This can fail with ErrSampleSize if there are fewer than two groups
+// or any group has fewer than two values, or ErrZeroVariance if any
+// group has zero variance.
+func BartlettTest(groups ...[]float64) (*BartlettTestResult, error) {
+	k := len(groups)
+	if k < 2 {
+		return nil, ErrSampleSize
+	}
+	n := 0
+	var pooled, sumLogVar, sumInv float64
+	for _, g := range groups {
+		if len(g) < 2 {
+			return nil, ErrSampleSize
+		}
+		v := Variance(g)
+		if v == 0 {
+			return nil, ErrZeroVariance
+		}
+		df := float64(len(g) - 1)
+		n += len(g)
+		pooled += df * v
+		sumLogVar += df * math.Log(v)
+		sumInv += 1 / df
+	}
+	dfw := float64(n - k)
+	pooled /= dfw
+
+	k2 := (dfw*math.Log(pooled) - sumLogVar) /
+		(1 + (sumInv-1/dfw)/(3*float64(k-1)))
+	p := 1 - ChiSquaredDist{float64(k - 1)}.CDF(k2)
+	return &BartlettTestResult{K2: k2, DoF: k - 1, P: p}, nil
+}
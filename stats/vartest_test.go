@@ -0,0 +1,70 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var unequalVarGroups = [][]float64{
+	{10.1, 9.8, 10.3, 10.0, 9.9, 10.2},
+	{8.0, 12.5, 9.1, 11.7, 7.4, 13.0, 10.2},
+	{5.0, 15.2, 9.9, 14.1, 3.8, 17.0},
+}
+
+func TestLeveneTest(t *testing.T) {
+	res, err := LeveneTest(unequalVarGroups...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.DF1 != 2 || res.DF2 != 16 || !aeq(res.W, 12.279661499561497) || !aeq(res.P, 0.0005864544456075113) {
+		t.Errorf("LeveneTest = %+v", res)
+	}
+
+	// Equal variances should not be rejected.
+	r := rand.New(rand.NewSource(1))
+	d := NormalDist{0, 1}
+	res, err = LeveneTest(SampleN(d, 50, r), SampleN(d, 60, r), SampleN(d, 40, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("LeveneTest of equal variances: P = %v, want large", res.P)
+	}
+
+	if _, err := LeveneTest([]float64{1, 2}); err != ErrSampleSize {
+		t.Errorf("LeveneTest of one group returned error %v, want ErrSampleSize", err)
+	}
+}
+
+func TestBartlettTest(t *testing.T) {
+	res, err := BartlettTest(unequalVarGroups...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.DoF != 2 || !aeq(res.K2, 26.794857839095013) || !aeq(res.P, 1.5190446817431066e-06) {
+		t.Errorf("BartlettTest = %+v", res)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	d := NormalDist{0, 1}
+	res, err = BartlettTest(SampleN(d, 50, r), SampleN(d, 60, r), SampleN(d, 40, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.P < 0.05 {
+		t.Errorf("BartlettTest of equal variances: P = %v, want large", res.P)
+	}
+
+	for _, groups := range [][][]float64{{{1, 2}}, {{1, 2}, {3}}} {
+		if _, err := BartlettTest(groups...); err != ErrSampleSize {
+			t.Errorf("BartlettTest(%v) returned error %v, want ErrSampleSize", groups, err)
+		}
+	}
+	if _, err := BartlettTest([]float64{1, 2}, []float64{3, 3}); err != ErrZeroVariance {
+		t.Errorf("BartlettTest with zero variance returned error %v, want ErrZeroVariance", err)
+	}
+}
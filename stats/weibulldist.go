@@ -0,0 +1,90 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+)
+
+// WeibullDist is a Weibull distribution with shape K and scale
+// Lambda. It has support [0, ∞).
+//
+// When used to model time to failure, K < 1 indicates a failure rate
+// that decreases over time, K = 1 a constant failure rate (in which
+// case this is an exponential distribution with rate 1/Lambda), and
+// K > 1 a failure rate that increases over time.
+type WeibullDist struct {
+	// K is the shape parameter. K > 0.
+	K float64
+
+	// Lambda is the scale parameter. Lambda > 0.
+	Lambda float64
+}
+
+func (d WeibullDist) PDF(x float64) float64 {
+	if x < 0 {
+		return 0
+	} else if x == 0 {
+		switch {
+		case d.K < 1:
+			return inf
+		case d.K == 1:
+			return 1 / d.Lambda
+		default:
+			return 0
+		}
+	}
+	z := x / d.Lambda
+	zk1 := math.Pow(z, d.K-1)
+	return d.K / d.Lambda * zk1 * math.Exp(-zk1*z)
+}
+
+func (d WeibullDist) CDF(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	return -math.Expm1(-math.Pow(x/d.Lambda, d.K))
+}
+
+func (d WeibullDist) InvCDF(p float64) (x float64) {
+	if p < 0 || p > 1 {
+		return nan
+	} else if p == 0 {
+		return 0
+	} else if p == 1 {
+		return inf
+	}
+	return d.Lambda * math.Pow(-math.Log1p(-p), 1/d.K)
+}
+
+// Rand returns a random sample drawn from d using inverse transform
+// sampling.
+func (d WeibullDist) Rand(r *rand.Rand) float64 {
+	var u float64
+	if r == nil {
+		u = rand.Float64()
+	} else {
+		u = r.Float64()
+	}
+	return d.InvCDF(u)
+}
+
+func (d WeibullDist) Bounds() (float64, float64) {
+	return 0, d.InvCDF(0.999)
+}
+
+func (d WeibullDist) Mean() float64 {
+	return d.Lambda * math.Gamma(1+1/d.K)
+}
+
+func (d WeibullDist) Variance() float64 {
+	g1 := math.Gamma(1 + 1/d.K)
+	return d.Lambda * d.Lambda * (math.Gamma(1+2/d.K) - g1*g1)
+}
+
+func (d WeibullDist) Median() float64 {
+	return d.Lambda * math.Pow(math.Ln2, 1/d.K)
+}
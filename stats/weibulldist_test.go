@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jgbaldwinbrown/go-moremath/vec"
+)
+
+func TestWeibullDist(t *testing.T) {
+	d := WeibullDist{K: 2, Lambda: 3}
+	testFunc(t, fmt.Sprintf("%+v.PDF", d), d.PDF, map[float64]float64{
+		-1: 0,
+		0:  0,
+		3:  2.0 / 3 * math.Exp(-1),
+	})
+	testFunc(t, fmt.Sprintf("%+v.CDF", d), d.CDF, map[float64]float64{
+		-1: 0,
+		0:  0,
+		3:  1 - math.Exp(-1),
+		6:  1 - math.Exp(-4),
+	})
+	testPDFIntegral(t, d, vec.Linspace(0, 10, 11))
+	for _, p := range vec.Linspace(0, 1, 11) {
+		if got := d.CDF(d.InvCDF(p)); !aeq(p, got) {
+			t.Errorf("%+v.CDF(InvCDF(%v)) = %v", d, p, got)
+		}
+	}
+	if m, want := d.Mean(), 1.5*math.Sqrt(math.Pi); !aeq(m, want) {
+		t.Errorf("%+v.Mean() = %v, want %v", d, m, want)
+	}
+	if v, want := d.Variance(), 9*(1-math.Pi/4); !aeq(v, want) {
+		t.Errorf("%+v.Variance() = %v, want %v", d, v, want)
+	}
+
+	// K=1 is an exponential distribution.
+	d = WeibullDist{K: 1, Lambda: 0.5}
+	exp := ExponentialDist{Rate: 2}
+	for _, x := range vec.Linspace(0, 5, 11) {
+		if !aeq(d.PDF(x), exp.PDF(x)) || !aeq(d.CDF(x), exp.CDF(x)) {
+			t.Errorf("%+v differs from %+v at %v", d, exp, x)
+		}
+	}
+}
+
+func TestWeibullDistHazard(t *testing.T) {
+	// The hazard function h(x) = PDF(x) / (1 - CDF(x)) is
+	// (K/Lambda)(x/Lambda)^(K-1), which is decreasing for K < 1,
+	// constant for K = 1, and increasing for K > 1.
+	hazard := func(d WeibullDist, x float64) float64 {
+		return d.PDF(x) / (1 - d.CDF(x))
+	}
+	xs := vec.Linspace(0.1, 3, 30)
+	for _, k := range []float64{0.5, 1, 2} {
+		d := WeibullDist{K: k, Lambda: 1}
+		for i := 1; i < len(xs); i++ {
+			h0, h1 := hazard(d, xs[i-1]), hazard(d, xs[i])
+			if want := k * math.Pow(xs[i], k-1); !aeq(h1, want) {
+				t.Errorf("%+v hazard(%v) = %v, want %v", d, xs[i], h1, want)
+			}
+			switch {
+			case k < 1 && !(h1 < h0),
+				k == 1 && !aeq(h1, h0),
+				k > 1 && !(h1 > h0):
+				t.Errorf("%+v hazard has wrong shape between %v and %v: %v, %v", d, xs[i-1], xs[i], h0, h1)
+			}
+		}
+	}
+}
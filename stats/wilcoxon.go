@@ -0,0 +1,162 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+
+	"github.com/jgbaldwinbrown/go-moremath/mathx"
+)
+
+// A WilcoxonSignedRankTestResult is the result of a Wilcoxon
+// signed-rank test.
+type WilcoxonSignedRankTestResult struct {
+	// N is the number of pairs with non-zero differences. Pairs
+	// with zero difference are dropped before ranking.
+	N int
+
+	// W is the signed-rank statistic W+: the sum of the ranks
+	// of the absolute differences x[i]-y[i] that are positive.
+	// Tied absolute differences are assigned their average rank.
+	// W is in the range [0, N(N+1)/2].
+	W float64
+
+	// AltHypothesis specifies the alternative hypothesis tested
+	// by this test against the null hypothesis that the
+	// differences are symmetric about 0.
+	AltHypothesis LocationHypothesis
+
+	// P is the p-value of the test for the given null
+	// hypothesis.
+	P float64
+}
+
+// WilcoxonExactLimit gives the largest number of non-zero
+// differences for which the exact distribution of W will be used by
+// WilcoxonSignedRankTest. Computing the exact distribution takes
+// O(N³) time.
+var WilcoxonExactLimit = 50
+
+// WilcoxonSignedRankTest performs a Wilcoxon signed-rank test of the
+// null hypothesis that the differences between paired samples x and
+// y are symmetric about 0 against the alternative hypothesis that
+// they tend to be less than or greater than 0.
+//
+// This is the paired counterpart of MannWhitneyUTest. For up to
+// WilcoxonExactLimit non-zero differences, the p-value is computed
+// from the exact permutation distribution of W, which accounts for
+// any ties. Otherwise, it uses a normal approximation with tie and
+// continuity correction.
+//
+// This can fail with ErrMismatchedSamples if x and y have different
+// lengths, ErrSampleSize if they are empty, or ErrSamplesEqual if all
+// differences are zero.
+func WilcoxonSignedRankTest(x, y []float64, alt LocationHypothesis) (*WilcoxonSignedRankTestResult, error) {
+	if len(x) != len(y) {
+		return nil, ErrMismatchedSamples
+	}
+	if len(x) == 0 {
+		return nil, ErrSampleSize
+	}
+
+	// Compute the non-zero differences.
+	var absDiffs []float64
+	var pos []bool
+	for i := range x {
+		d := x[i] - y[i]
+		if d != 0 {
+			absDiffs = append(absDiffs, math.Abs(d))
+			pos = append(pos, d > 0)
+		}
+	}
+	n := len(absDiffs)
+	if n == 0 {
+		return nil, ErrSamplesEqual
+	}
+
+	ranks, ties := ranksAndTies(absDiffs, TieAverage)
+	w := 0.0
+	for i, r := range ranks {
+		if pos[i] {
+			w += r
+		}
+	}
+
+	var p float64
+	if n <= WilcoxonExactLimit {
+		less, greater := wilcoxonExactTails(ranks, w)
+		switch alt {
+		case LocationDiffers:
+			p = math.Min(1, 2*math.Min(less, greater))
+		case LocationLess:
+			p = less
+		case LocationGreater:
+			p = greater
+		}
+	} else {
+		fn := float64(n)
+		μ := fn * (fn + 1) / 4
+		σ := math.Sqrt(fn*(fn+1)*(2*fn+1)/24 - tieCorrection(ties)/48)
+		numer := w - μ
+		// Perform continuity correction.
+		switch alt {
+		case LocationDiffers:
+			numer -= mathx.Sign(numer) * 0.5
+		case LocationLess:
+			numer += 0.5
+		case LocationGreater:
+			numer -= 0.5
+		}
+		z := numer / σ
+		switch alt {
+		case LocationDiffers:
+			p = 2 * math.Min(StdNormal.CDF(z), 1-StdNormal.CDF(z))
+		case LocationLess:
+			p = StdNormal.CDF(z)
+		case LocationGreater:
+			p = 1 - StdNormal.CDF(z)
+		}
+	}
+
+	return &WilcoxonSignedRankTestResult{N: n, W: w, AltHypothesis: alt, P: p}, nil
+}
+
+// wilcoxonExactTails returns Pr[W+ <= w] and Pr[W+ >= w] under the
+// null hypothesis, where W+ is the sum of a random subset of ranks
+// in which each rank is included independently with probability 1/2.
+//
+// Average ranks are multiples of 1/2, so this computes the
+// distribution of 2W+ over the integers by dynamic programming.
+func wilcoxonExactTails(ranks []float64, w float64) (less, greater float64) {
+	total := 0
+	for _, r := range ranks {
+		total += int(2 * r)
+	}
+	// counts[s] is the probability that 2W+ == s.
+	counts := make([]float64, total+1)
+	counts[0] = 1
+	upper := 0
+	for _, r := range ranks {
+		r2 := int(2 * r)
+		upper += r2
+		for s := upper; s >= 0; s-- {
+			c := counts[s] / 2
+			if s >= r2 {
+				c += counts[s-r2] / 2
+			}
+			counts[s] = c
+		}
+	}
+	w2 := int(math.Round(2 * w))
+	for s, c := range counts {
+		if s <= w2 {
+			less += c
+		}
+		if s >= w2 {
+			greater += c
+		}
+	}
+	return math.Min(less, 1), math.Min(greater, 1)
+}
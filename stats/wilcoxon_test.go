@@ -0,0 +1,88 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package stats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWilcoxonSignedRankTest(t *testing.T) {
+	// Differences are 1.5, -0.5, 2, 3, 4 with ranks 2, 1, 3, 4,
+	// 5, so W+ = 14. Of the 32 equally likely sign assignments,
+	// only W+ = 14 and W+ = 15 are at least as large, so
+	// Pr[W+ >= 14] = 2/32.
+	x := []float64{2.5, 1, 5, 7, 9, 3}
+	y := []float64{1, 1.5, 3, 4, 5, 3}
+	for _, c := range []struct {
+		alt LocationHypothesis
+		p   float64
+	}{
+		{LocationGreater, 2.0 / 32},
+		{LocationLess, 31.0 / 32},
+		{LocationDiffers, 4.0 / 32},
+	} {
+		res, err := WilcoxonSignedRankTest(x, y, c.alt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.N != 5 || res.W != 14 || res.AltHypothesis != c.alt || !aeq(res.P, c.p) {
+			t.Errorf("WilcoxonSignedRankTest(%v) = %+v, want N=5, W=14, P=%v", c.alt, res, c.p)
+		}
+	}
+
+	// With ties. |d| = 1, 1, 2 with ranks 1.5, 1.5, 3 and W+ =
+	// 4.5. The 8 subset sums are 0, 1.5, 1.5, 3, 3, 4.5, 4.5, 6.
+	res, err := WilcoxonSignedRankTest([]float64{1, -1, 2}, []float64{0, 0, 0}, LocationGreater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.W != 4.5 || !aeq(res.P, 3.0/8) {
+		t.Errorf("WilcoxonSignedRankTest with ties = %+v, want W=4.5, P=%v", res, 3.0/8)
+	}
+
+	// Large samples use the normal approximation.
+	n := 100
+	x, y = make([]float64, n), make([]float64, n)
+	for i := range x {
+		x[i] = float64(i + 1)
+		if i%3 == 0 {
+			x[i] = -x[i]
+		}
+	}
+	res, err = WilcoxonSignedRankTest(x, y, LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fn := float64(n)
+	μ, σ := fn*(fn+1)/4, math.Sqrt(fn*(fn+1)*(2*fn+1)/24)
+	z := (res.W - μ - 0.5) / σ
+	if want := 2 * (1 - StdNormal.CDF(z)); !aeq(res.P, want) {
+		t.Errorf("WilcoxonSignedRankTest(large) P = %v, want %v", res.P, want)
+	}
+
+	// The normal approximation should be close to the exact
+	// distribution near the limit.
+	exact, err := WilcoxonSignedRankTest(x[:50], y[:50], LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(l int) { WilcoxonExactLimit = l }(WilcoxonExactLimit)
+	WilcoxonExactLimit = 0
+	approx, err := WilcoxonSignedRankTest(x[:50], y[:50], LocationDiffers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(exact.P-approx.P) > 0.01 {
+		t.Errorf("exact P %v and approximate P %v differ", exact.P, approx.P)
+	}
+
+	if _, err := WilcoxonSignedRankTest(x, y[:1], LocationDiffers); err != ErrMismatchedSamples {
+		t.Errorf("mismatched lengths returned error %v, want ErrMismatchedSamples", err)
+	}
+	if _, err := WilcoxonSignedRankTest(y, y, LocationDiffers); err != ErrSamplesEqual {
+		t.Errorf("all-zero differences returned error %v, want ErrSamplesEqual", err)
+	}
+}